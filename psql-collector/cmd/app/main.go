@@ -1,17 +1,30 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	_ "github.com/lib/pq"
 	"log"
 	"os"
 	"postgresHelper/cmd"
 	psql_helper "postgresHelper/internal/app/psql-helper"
+	"postgresHelper/internal/autovacuum"
 	"postgresHelper/internal/collector"
 	"postgresHelper/internal/config"
+	"postgresHelper/internal/exporter"
+	"postgresHelper/internal/loadgen"
+	"postgresHelper/internal/maintenance"
 	"postgresHelper/internal/pgbench"
+	"postgresHelper/internal/policy"
+	"postgresHelper/internal/replay"
+	"postgresHelper/internal/runner"
+	"postgresHelper/internal/sampler"
+	"postgresHelper/internal/storage"
+	"postgresHelper/internal/usecase/advisor"
 	"postgresHelper/internal/usecase/loader"
 	"postgresHelper/internal/usecase/selector"
 	"postgresHelper/internal/usecase/setter"
+	"postgresHelper/internal/usecase/snapshot"
 )
 
 func main() {
@@ -30,18 +43,80 @@ func main() {
 	}
 	defer conn.Close()
 
-	collect := collector.NewCollector(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	benchLoader := loader.New(pgbench.New(conn, config.ConfigStruct))
-	metricsSelector := selector.New(collect, config.ConfigStruct.PG)
-	knobsSetter := setter.New(collect)
+	collect, err := collector.NewCollector(ctx, conn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("detected postgres server_version_num %d", collect.ServerVersion())
+
+	waitSampler := sampler.New(collect, config.ConfigStruct.Waits)
+	waitSampler.Run(ctx)
+
+	knobPolicy, err := policy.New(config.ConfigStruct.Knobs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	knobs, err := collect.CollectKnobs(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = knobPolicy.Validate(knobs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var bench loader.Bench
+	switch config.ConfigStruct.Loader.Engine {
+	case "", "pgbench":
+		bench = pgbench.New(conn, config.ConfigStruct)
+	case "native":
+		bench, err = loadgen.New(conn, config.ConfigStruct.Pgbench, config.ConfigStruct.Loader)
+	case "replay":
+		bench, err = replay.New(conn, config.ConfigStruct.Loader)
+	default:
+		err = fmt.Errorf("load_generator engine: unknown engine %q, expected pgbench, native or replay", config.ConfigStruct.Loader.Engine)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	benchLoader := loader.New(bench)
+	vacuumHelper := autovacuum.New(conn)
+	metricsSelector := selector.New(collect, config.ConfigStruct.PG, knobPolicy, waitSampler, config.ConfigStruct.Waits, vacuumHelper)
+	knobsSetter := setter.New(collect, knobPolicy)
+	history, err := storage.New(config.ConfigStruct.History, config.ConfigStruct.Snaps)
+	if err != nil {
+		log.Fatal(err)
+	}
+	knobsSnapshotter := snapshot.New(collect, knobsSetter, history)
 
-	delivery := psql_helper.New(metricsSelector, benchLoader, knobsSetter)
+	historyRunner, err := runner.New(metricsSelector, metricsSelector, history, config.ConfigStruct.History)
+	if err != nil {
+		log.Fatal(err)
+	}
+	metricsSelector.SetSampledGroups(historyRunner.Groups())
+	historyRunner.Run(ctx)
+
+	tableAdvisor := advisor.New(collect, vacuumHelper, policy.NewTableOptions(config.ConfigStruct.Tables))
+
+	maintenanceExecutor := maintenance.New(ctx, collect)
+
+	delivery := psql_helper.New(metricsSelector, benchLoader, knobsSetter, knobsSnapshotter, tableAdvisor, maintenanceExecutor, history)
 	grpcServer, err := cmd.RunGRPCServer(delivery, &config.ConfigStruct.GRPC)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer grpcServer.Close()
 
+	if config.ConfigStruct.Metrics.Enabled {
+		metricsServer, err := cmd.RunMetricsServer(exporter.New(metricsSelector, history, benchLoader, config.ConfigStruct.Metrics), &config.ConfigStruct.Metrics.HTTPConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer metricsServer.Close()
+	}
+
 	cmd.Lock(make(chan os.Signal, 1))
 }
@@ -4,10 +4,12 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"postgresHelper/internal/config"
 	"postgresHelper/lib/grpc_server"
+	"postgresHelper/lib/http_server"
 	desc "postgresHelper/pkg/collector"
 	"strconv"
 	"syscall"
@@ -26,6 +28,20 @@ func RunGRPCServer(implementation desc.CollectorServer, cfg *grpc_server.GRPCCon
 	return grpcServer, nil
 }
 
+func RunMetricsServer(handler http.Handler, cfg *http_server.HTTPConfig) (*http_server.HTTPServer, error) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+
+	httpServer, err := http_server.NewHTTPServer(cfg, mux)
+	if err != nil {
+		return nil, fmt.Errorf("http_server.NewHTTPServer: %w", err)
+	}
+	httpServer.Run()
+
+	log.Printf("started metrics server at %s:%s", cfg.Host, strconv.Itoa(cfg.Port))
+	return httpServer, nil
+}
+
 func CreatePostgresConn(cfg *config.Postgres) (*sql.DB, error) {
 	connStr := cfg.ConnectionString()
 
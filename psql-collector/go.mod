@@ -3,6 +3,7 @@ module postgresHelper
 go 1.22
 
 require (
+	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/lib/pq v1.10.9
 	github.com/samber/lo v1.39.0
 	google.golang.org/grpc v1.62.0
@@ -12,7 +13,6 @@ require (
 
 require (
 	github.com/golang/protobuf v1.5.3 // indirect
-	github.com/kelseyhightower/envconfig v1.4.0 // indirect
 	golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17 // indirect
 	golang.org/x/net v0.20.0 // indirect
 	golang.org/x/sys v0.16.0 // indirect
@@ -0,0 +1,130 @@
+package psql_helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+)
+
+func (d *Delivery) RecommendTableOptions(ctx context.Context, _ *desc.RecommendTableOptionsRequest) (*desc.RecommendTableOptionsResponse, error) {
+	recommendations, err := d.advisor.RecommendTableOptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("advisor.RecommendTableOptions: %w", err)
+	}
+
+	tables := lo.Map(recommendations, func(recommendation model.TableOptionsRecommendation, _ int) *desc.RecommendTableOptionsResponse_Table {
+		return &desc.RecommendTableOptionsResponse_Table{
+			SchemaName:   recommendation.SchemaName,
+			RelationName: recommendation.RelationName,
+			Options: lo.Map(recommendation.Options, func(option model.TableOptionRecommendation, _ int) *desc.RecommendTableOptionsResponse_Option {
+				return &desc.RecommendTableOptionsResponse_Option{
+					Name:         option.Name,
+					Value:        option.Value,
+					CurrentValue: option.CurrentValue,
+					Rationale:    option.Rationale,
+				}
+			}),
+		}
+	})
+
+	return &desc.RecommendTableOptionsResponse{Tables: tables}, nil
+}
+
+func (d *Delivery) SetTableOptions(ctx context.Context, req *desc.SetTableOptionsRequest) (*desc.SetTableOptionsResponse, error) {
+	tables := req.GetTables()
+	if len(tables) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tables should be specified")
+	}
+	for _, table := range tables {
+		if table.GetSchemaName() == "" || table.GetRelationName() == "" {
+			return nil, status.Error(codes.InvalidArgument, "table schema and relation names should not be empty")
+		}
+		for _, option := range table.GetOptions() {
+			if option.GetName() == "" || option.GetValue() == "" {
+				return nil, status.Error(codes.InvalidArgument, "option name and value should not be empty")
+			}
+		}
+	}
+
+	modelTables := lo.Map(tables, func(table *desc.SetTableOptionsRequest_Table, _ int) model.TableOptions {
+		return model.TableOptions{
+			SchemaName:   table.GetSchemaName(),
+			RelationName: table.GetRelationName(),
+			Options: lo.Map(table.GetOptions(), func(option *desc.SetTableOptionsRequest_Option, _ int) model.TableOption {
+				return model.TableOption{Name: option.GetName(), Value: option.GetValue()}
+			}),
+		}
+	})
+
+	results, err := d.advisor.SetTableOptions(ctx, modelTables)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidTableOption) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, model.ErrTableOptionForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, fmt.Errorf("advisor.SetTableOptions: %w", err)
+	}
+
+	options := lo.Map(results, func(result model.TableOptionApplyResult, _ int) *desc.SetTableOptionsResponse_Option {
+		return &desc.SetTableOptionsResponse_Option{
+			SchemaName:     result.SchemaName,
+			RelationName:   result.RelationName,
+			Name:           result.Name,
+			RequestedValue: result.RequestedValue,
+			EffectiveValue: result.EffectiveValue,
+			Status:         toDescKnobApplyStatus(result.Status),
+			Error:          result.Error,
+		}
+	})
+
+	return &desc.SetTableOptionsResponse{Options: options}, nil
+}
+
+func (d *Delivery) RecommendIndexes(ctx context.Context, _ *desc.RecommendIndexesRequest) (*desc.RecommendIndexesResponse, error) {
+	advice, err := d.advisor.RecommendIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("advisor.RecommendIndexes: %w", err)
+	}
+
+	recommendations := lo.Map(advice.Recommendations, func(recommendation model.IndexRecommendation, _ int) *desc.RecommendIndexesResponse_Recommendation {
+		return &desc.RecommendIndexesResponse_Recommendation{
+			Kind:         toDescIndexRecommendationKind(recommendation.Kind),
+			SchemaName:   recommendation.SchemaName,
+			TableName:    recommendation.TableName,
+			IndexName:    recommendation.IndexName,
+			RelatedIndex: recommendation.RelatedIndex,
+			Reason:       recommendation.Reason,
+			SavingsBytes: recommendation.SavingsBytes,
+			Statement:    recommendation.Statement,
+		}
+	})
+
+	return &desc.RecommendIndexesResponse{
+		StatsReset:      toDescTimestamp(advice.StatsReset),
+		Recommendations: recommendations,
+	}, nil
+}
+
+func toDescIndexRecommendationKind(kind model.IndexRecommendationKind) desc.IndexRecommendationKind {
+	switch kind {
+	case model.IndexUnused:
+		return desc.IndexRecommendationKind_UnusedIndex
+	case model.IndexDuplicate:
+		return desc.IndexRecommendationKind_DuplicateIndex
+	case model.IndexOverlapping:
+		return desc.IndexRecommendationKind_OverlappingIndex
+	case model.IndexInvalid:
+		return desc.IndexRecommendationKind_InvalidIndex
+	case model.IndexBloated:
+		return desc.IndexRecommendationKind_BloatedIndex
+	default:
+		return desc.IndexRecommendationKind_IndexRecommendationKindUnspecified
+	}
+}
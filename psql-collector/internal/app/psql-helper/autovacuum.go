@@ -0,0 +1,86 @@
+package psql_helper
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgresHelper/internal/autovacuum"
+	desc "postgresHelper/pkg/collector"
+	"time"
+)
+
+func (d *Delivery) CollectAutovacuumState(ctx context.Context, req *desc.CollectAutovacuumStateRequest) (*desc.CollectAutovacuumStateResponse, error) {
+	longTransaction := time.Duration(req.GetLongTransactionSeconds()) * time.Second
+	stats, err := d.selector.GetAutovacuumState(ctx, longTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("selector.GetAutovacuumState: %w", err)
+	}
+
+	return &desc.CollectAutovacuumStateResponse{
+		DatabaseName: stats.DatabaseName,
+		Settings: &desc.CollectAutovacuumStateResponse_Settings{
+			Enabled:               stats.AutovacuumEnabled,
+			MaxWorkers:            stats.AutovacuumMaxWorkers,
+			WorkMem:               stats.AutovacuumWorkMem,
+			NaptimeSeconds:        stats.AutovacuumNaptimeSeconds,
+			VacuumThreshold:       stats.AutovacuumVacuumThreshold,
+			AnalyzeThreshold:      stats.AutovacuumAnalyzeThreshold,
+			VacuumScaleFactor:     stats.AutovacuumVacuumScaleFactor,
+			AnalyzeScaleFactor:    stats.AutovacuumAnalyzeScaleFactor,
+			FreezeMaxAge:          stats.AutovacuumFreezeMaxAge,
+			MultixactFreezeMaxAge: stats.AutovacuumMultixactFreezeMaxAge,
+			VacuumCostDelay:       stats.AutovacuumVacuumCostDelay,
+			VacuumCostLimit:       stats.AutovacuumVacuumCostLimit,
+		},
+		Relations: lo.Map(stats.Relations, func(relation autovacuum.VacuumStatsEntry, _ int) *desc.CollectAutovacuumStateResponse_Relation {
+			return &desc.CollectAutovacuumStateResponse_Relation{
+				SchemaName:           relation.SchemaName,
+				RelationName:         relation.RelationName,
+				LiveRows:             relation.LiveRowCount,
+				DeadRows:             relation.DeadRowCount,
+				ModifiedSinceAnalyze: relation.ModifiedSinceAnalyze,
+				XidAge:               relation.Relfrozenxid,
+				MultixactAge:         relation.Relminmxid,
+				LastVacuum:           toDescTimestamp(relation.LastManualVacuumRun),
+				LastAutovacuum:       toDescTimestamp(relation.LastAutoVacuumRun),
+				LastAnalyze:          toDescTimestamp(relation.LastManualAnalyzeRun),
+				LastAutoanalyze:      toDescTimestamp(relation.LastAutoAnalyzeRun),
+				Settings: &desc.CollectAutovacuumStateResponse_Settings{
+					Enabled:               relation.AutovacuumEnabled,
+					VacuumThreshold:       relation.AutovacuumVacuumThreshold,
+					AnalyzeThreshold:      relation.AutovacuumAnalyzeThreshold,
+					VacuumScaleFactor:     relation.AutovacuumVacuumScaleFactor,
+					AnalyzeScaleFactor:    relation.AutovacuumAnalyzeScaleFactor,
+					FreezeMaxAge:          relation.AutovacuumFreezeMaxAge,
+					MultixactFreezeMaxAge: relation.AutovacuumMultixactFreezeMaxAge,
+					VacuumCostDelay:       relation.AutovacuumVacuumCostDelay,
+					VacuumCostLimit:       relation.AutovacuumVacuumCostLimit,
+				},
+				Fillfactor:   relation.Fillfactor,
+				Reloptions:   relation.Reloptions,
+				NeedsVacuum:  relation.NeedsVacuum(),
+				NeedsAnalyze: relation.NeedsAnalyze(),
+			}
+		}),
+		LongRunningTransactions: lo.Map(stats.LongRunningTransactions, func(transaction autovacuum.LongRunningTransaction, _ int) *desc.CollectAutovacuumStateResponse_Transaction {
+			return &desc.CollectAutovacuumStateResponse_Transaction{
+				Pid:              transaction.Pid,
+				User:             transaction.User,
+				State:            transaction.State,
+				Query:            transaction.Query,
+				TransactionStart: timestamppb.New(transaction.TransactionStart),
+				DurationMs:       transaction.Duration,
+				BackendXminAge:   transaction.BackendXminAge,
+			}
+		}),
+	}, nil
+}
+
+func toDescTimestamp(t sql.Null[time.Time]) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.V)
+}
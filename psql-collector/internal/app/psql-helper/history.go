@@ -0,0 +1,78 @@
+package psql_helper
+
+import (
+	"context"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+	"time"
+)
+
+func (d *Delivery) ListMetricSamples(_ context.Context, req *desc.ListMetricSamplesRequest) (*desc.ListMetricSamplesResponse, error) {
+	from, to, err := fromDescTimeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	names := req.GetNames()
+	samples := d.history.ListMetricSamples(from, to)
+
+	return &desc.ListMetricSamplesResponse{
+		Samples: lo.Map(samples, func(sample model.MetricSample, _ int) *desc.ListMetricSamplesResponse_Sample {
+			metrics := sample.Metrics
+			if len(names) > 0 {
+				metrics = lo.Filter(metrics, func(metric model.InternalMetric, _ int) bool {
+					return lo.Contains(names, metric.Name)
+				})
+			}
+
+			return &desc.ListMetricSamplesResponse_Sample{
+				TakenAt: timestamppb.New(sample.TakenAt),
+				Metrics: lo.Map(metrics, func(metric model.InternalMetric, _ int) *desc.CollectInternalMetricsResponse_Metric {
+					return toDescMetric(metric)
+				}),
+			}
+		}),
+	}, nil
+}
+
+func (d *Delivery) ListKnobSamples(_ context.Context, req *desc.ListKnobSamplesRequest) (*desc.ListKnobSamplesResponse, error) {
+	from, to, err := fromDescTimeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	samples := d.history.ListKnobSamples(from, to)
+
+	descSamples := make([]*desc.ListKnobSamplesResponse_Sample, 0, len(samples))
+	for _, sample := range samples {
+		knobs, err := toDescKnobs(sample.Knobs)
+		if err != nil {
+			return nil, err
+		}
+		descSamples = append(descSamples, &desc.ListKnobSamplesResponse_Sample{
+			TakenAt: timestamppb.New(sample.TakenAt),
+			Knobs:   knobs,
+		})
+	}
+
+	return &desc.ListKnobSamplesResponse{Samples: descSamples}, nil
+}
+
+func fromDescTimeRange(from, to *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	var fromTime time.Time
+	if from != nil {
+		fromTime = from.AsTime()
+	}
+	toTime := time.Now()
+	if to != nil {
+		toTime = to.AsTime()
+	}
+	if toTime.Before(fromTime) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "to should not be before from")
+	}
+	return fromTime, toTime, nil
+}
@@ -0,0 +1,34 @@
+package psql_helper
+
+import (
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+)
+
+func (d *Delivery) CollectBlockingTree(ctx context.Context, _ *desc.CollectBlockingTreeRequest) (*desc.CollectBlockingTreeResponse, error) {
+	roots, err := d.selector.ListBlockingTree(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("selector.ListBlockingTree: %w", err)
+	}
+
+	return &desc.CollectBlockingTreeResponse{Roots: toDescBlockingNodes(roots)}, nil
+}
+
+func toDescBlockingNodes(nodes []model.BlockingNode) []*desc.BlockingNode {
+	return lo.Map(nodes, func(node model.BlockingNode, _ int) *desc.BlockingNode {
+		return &desc.BlockingNode{
+			Pid:        node.Pid,
+			User:       node.User,
+			Query:      node.Query,
+			State:      node.State,
+			LockMode:   node.LockMode,
+			LockType:   node.LockType,
+			Relation:   node.Relation,
+			WaitTimeMs: node.WaitTime,
+			Blocked:    toDescBlockingNodes(node.Blocked),
+		}
+	})
+}
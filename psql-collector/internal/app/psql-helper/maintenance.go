@@ -0,0 +1,160 @@
+package psql_helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+)
+
+func (d *Delivery) StartMaintenance(ctx context.Context, req *desc.StartMaintenanceRequest) (*desc.StartMaintenanceResponse, error) {
+	action := fromDescMaintenanceAction(req.GetAction())
+	if action == model.MaintenanceActionUnspecified {
+		return nil, status.Error(codes.InvalidArgument, "action should be specified")
+	}
+	if len(req.GetRelations()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "relations should be specified")
+	}
+
+	relations := make([]model.Relation, 0, len(req.GetRelations()))
+	for _, relation := range req.GetRelations() {
+		if relation.GetSchemaName() == "" || relation.GetRelationName() == "" {
+			return nil, status.Error(codes.InvalidArgument, "relation schema and name should not be empty")
+		}
+		relations = append(relations, model.Relation{
+			SchemaName:   relation.GetSchemaName(),
+			RelationName: relation.GetRelationName(),
+		})
+	}
+
+	jobs, err := d.maintenance.Start(ctx, action, relations)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidMaintenance) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, model.ErrMaintenanceConflict) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("maintenance.Start: %w", err)
+	}
+
+	return &desc.StartMaintenanceResponse{Jobs: toDescMaintenanceJobs(jobs)}, nil
+}
+
+func (d *Delivery) GetMaintenanceJob(ctx context.Context, req *desc.GetMaintenanceJobRequest) (*desc.GetMaintenanceJobResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id should not be empty")
+	}
+
+	job, err := d.maintenance.Get(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, model.ErrJobNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, fmt.Errorf("maintenance.Get: %w", err)
+	}
+
+	return &desc.GetMaintenanceJobResponse{Job: toDescMaintenanceJob(job)}, nil
+}
+
+func (d *Delivery) ListMaintenanceJobs(ctx context.Context, _ *desc.ListMaintenanceJobsRequest) (*desc.ListMaintenanceJobsResponse, error) {
+	return &desc.ListMaintenanceJobsResponse{Jobs: toDescMaintenanceJobs(d.maintenance.List(ctx))}, nil
+}
+
+func (d *Delivery) CancelMaintenanceJob(ctx context.Context, req *desc.CancelMaintenanceJobRequest) (*desc.CancelMaintenanceJobResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id should not be empty")
+	}
+
+	job, err := d.maintenance.Cancel(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, model.ErrJobNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, model.ErrJobFinished) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("maintenance.Cancel: %w", err)
+	}
+
+	return &desc.CancelMaintenanceJobResponse{Job: toDescMaintenanceJob(job)}, nil
+}
+
+func toDescMaintenanceJobs(jobs []model.MaintenanceJob) []*desc.MaintenanceJob {
+	return lo.Map(jobs, func(job model.MaintenanceJob, _ int) *desc.MaintenanceJob {
+		return toDescMaintenanceJob(job)
+	})
+}
+
+func toDescMaintenanceJob(job model.MaintenanceJob) *desc.MaintenanceJob {
+	descJob := &desc.MaintenanceJob{
+		Id:              job.ID,
+		Action:          toDescMaintenanceAction(job.Action),
+		SchemaName:      job.SchemaName,
+		RelationName:    job.RelationName,
+		Status:          toDescMaintenanceJobStatus(job.Status),
+		StartedAt:       timestamppb.New(job.StartedAt),
+		DurationSeconds: job.Duration().Seconds(),
+		Phase:           job.Progress.Phase,
+		BlocksTotal:     job.Progress.BlocksTotal,
+		BlocksDone:      job.Progress.BlocksDone,
+		SizeBeforeBytes: job.SizeBefore,
+		Error:           job.Error,
+	}
+	if job.SizeAfter.Valid {
+		descJob.SizeAfterBytes = &job.SizeAfter.V
+	}
+	if reclaimed := job.ReclaimedBytes(); reclaimed.Valid {
+		descJob.ReclaimedBytes = &reclaimed.V
+	}
+	if !job.FinishedAt.IsZero() {
+		descJob.FinishedAt = timestamppb.New(job.FinishedAt)
+	}
+	return descJob
+}
+
+func fromDescMaintenanceAction(action desc.MaintenanceAction) model.MaintenanceAction {
+	switch action {
+	case desc.MaintenanceAction_VacuumAnalyze:
+		return model.MaintenanceVacuum
+	case desc.MaintenanceAction_AnalyzeOnly:
+		return model.MaintenanceAnalyze
+	case desc.MaintenanceAction_ReindexConcurrently:
+		return model.MaintenanceReindex
+	default:
+		return model.MaintenanceActionUnspecified
+	}
+}
+
+func toDescMaintenanceAction(action model.MaintenanceAction) desc.MaintenanceAction {
+	switch action {
+	case model.MaintenanceVacuum:
+		return desc.MaintenanceAction_VacuumAnalyze
+	case model.MaintenanceAnalyze:
+		return desc.MaintenanceAction_AnalyzeOnly
+	case model.MaintenanceReindex:
+		return desc.MaintenanceAction_ReindexConcurrently
+	default:
+		return desc.MaintenanceAction_MaintenanceActionUnspecified
+	}
+}
+
+func toDescMaintenanceJobStatus(jobStatus model.MaintenanceJobStatus) desc.MaintenanceJobStatus {
+	switch jobStatus {
+	case model.MaintenanceJobRunning:
+		return desc.MaintenanceJobStatus_Running
+	case model.MaintenanceJobSucceeded:
+		return desc.MaintenanceJobStatus_Succeeded
+	case model.MaintenanceJobFailed:
+		return desc.MaintenanceJobStatus_Failed
+	case model.MaintenanceJobCanceled:
+		return desc.MaintenanceJobStatus_Canceled
+	default:
+		return desc.MaintenanceJobStatus_MaintenanceJobStatusUnspecified
+	}
+}
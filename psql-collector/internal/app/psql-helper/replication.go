@@ -0,0 +1,43 @@
+package psql_helper
+
+import (
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+)
+
+func (d *Delivery) CollectReplication(ctx context.Context, _ *desc.CollectReplicationRequest) (*desc.CollectReplicationResponse, error) {
+	state, err := d.selector.GetReplication(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("selector.GetReplication: %w", err)
+	}
+
+	return &desc.CollectReplicationResponse{
+		InRecovery: state.InRecovery,
+		Replicas: lo.Map(state.Replicas, func(replica model.Replica, _ int) *desc.CollectReplicationResponse_Replica {
+			return &desc.CollectReplicationResponse_Replica{
+				Pid:             replica.Pid,
+				ApplicationName: replica.ApplicationName,
+				ClientAddr:      replica.ClientAddr,
+				State:           replica.State,
+				SyncState:       replica.SyncState,
+				WriteLagMs:      replica.WriteLag,
+				FlushLagMs:      replica.FlushLag,
+				ReplayLagMs:     replica.ReplayLag,
+				ReplayLagBytes:  replica.ReplayLagBytes,
+			}
+		}),
+		Slots: lo.Map(state.Slots, func(slot model.ReplicationSlot, _ int) *desc.CollectReplicationResponse_Slot {
+			return &desc.CollectReplicationResponse_Slot{
+				SlotName:         slot.SlotName,
+				SlotType:         slot.SlotType,
+				Database:         slot.Database,
+				Active:           slot.Active,
+				RetainedWalBytes: slot.RetainedWalBytes,
+				WalStatus:        slot.WalStatus,
+			}
+		}),
+	}, nil
+}
@@ -3,44 +3,90 @@ package psql_helper
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"postgresHelper/internal/autovacuum"
 	"postgresHelper/internal/model"
 	desc "postgresHelper/pkg/collector"
 	"reflect"
+	"strconv"
 	"time"
 )
 
+// maxWindowSeconds caps the window CollectInternalMetrics waits for before returning deltas.
+const maxWindowSeconds = 300
+
 type Delivery struct {
 	desc.CollectorServer
-	selector Selector
-	loader   Loader
-	setter   Setter
+	selector    Selector
+	loader      Loader
+	setter      Setter
+	snapshotter Snapshotter
+	advisor     Advisor
+	maintenance Maintenance
+	history     History
 }
 
-func New(selector Selector, loader Loader, setter Setter) *Delivery {
+func New(selector Selector, loader Loader, setter Setter, snapshotter Snapshotter, advisor Advisor, maintenance Maintenance, history History) *Delivery {
 	return &Delivery{
-		selector: selector,
-		loader:   loader,
-		setter:   setter,
+		selector:    selector,
+		loader:      loader,
+		setter:      setter,
+		snapshotter: snapshotter,
+		advisor:     advisor,
+		maintenance: maintenance,
+		history:     history,
 	}
 }
 
 type Loader interface {
-	RunLoad(ctx context.Context) (<-chan model.ExternalMetric, <-chan error)
+	RunLoad(ctx context.Context, workload model.Workload) (<-chan model.ExternalMetric, <-chan error)
 	InitLoad(ctx context.Context) error
 }
 
 type Selector interface {
 	ListAllMetrics(ctx context.Context) ([]model.InternalMetric, error)
-	ListAllAggregatedMetrics(ctx context.Context) ([]model.InternalMetric, error)
+	ListAllAggregatedMetrics(ctx context.Context, mode model.MetricsMode, window time.Duration, client string) ([]model.InternalMetric, time.Duration, error)
 	ListKnobs(ctx context.Context) ([]model.Knob, error)
+	ListTopStatements(ctx context.Context, limit int, orderBy model.StatementsOrder) ([]model.StatementStat, error)
+	ListBlockingTree(ctx context.Context) ([]model.BlockingNode, error)
+	ListWaitEvents(ctx context.Context, window time.Duration) model.WaitEventHistogram
+	GetReplication(ctx context.Context) (model.ReplicationState, error)
+	GetAutovacuumState(ctx context.Context, longTransaction time.Duration) (autovacuum.VacuumStats, error)
+	SubscribeAggregatedMetrics(ctx context.Context, interval time.Duration, groups []model.MetricGroup, mode model.MetricsMode) (<-chan model.MetricSnapshot, error)
 }
 
 type Setter interface {
-	SetKnobs(ctx context.Context, knobs []model.Knob) error
+	SetKnobs(ctx context.Context, knobs []model.Knob) ([]model.KnobApplyResult, error)
+}
+
+type Snapshotter interface {
+	CreateSnapshot(ctx context.Context, name string) (model.KnobSnapshot, error)
+	ListSnapshots(ctx context.Context) []model.KnobSnapshot
+	RestoreSnapshot(ctx context.Context, name string) ([]model.KnobApplyResult, error)
+	ResetKnobs(ctx context.Context) ([]string, error)
+}
+
+type Advisor interface {
+	RecommendTableOptions(ctx context.Context) ([]model.TableOptionsRecommendation, error)
+	SetTableOptions(ctx context.Context, tables []model.TableOptions) ([]model.TableOptionApplyResult, error)
+	RecommendIndexes(ctx context.Context) (model.IndexAdvice, error)
+}
+
+type Maintenance interface {
+	Start(ctx context.Context, action model.MaintenanceAction, relations []model.Relation) ([]model.MaintenanceJob, error)
+	Get(ctx context.Context, id string) (model.MaintenanceJob, error)
+	List(ctx context.Context) []model.MaintenanceJob
+	Cancel(ctx context.Context, id string) (model.MaintenanceJob, error)
+}
+
+type History interface {
+	ListMetricSamples(from, to time.Time) []model.MetricSample
+	ListKnobSamples(from, to time.Time) []model.KnobSample
 }
 
 func (d *Delivery) CollectKnobs(ctx context.Context, _ *desc.CollectKnobsRequest) (*desc.CollectKnobsResponse, error) {
@@ -49,24 +95,39 @@ func (d *Delivery) CollectKnobs(ctx context.Context, _ *desc.CollectKnobsRequest
 		return nil, fmt.Errorf("selector.ListKnobs: %w", err)
 	}
 
-	return toDescKnobs(knobs)
+	descKnobs, err := toDescKnobs(knobs)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.CollectKnobsResponse{Knobs: descKnobs}, nil
 }
 
-func toDescKnobs(knobs []model.Knob) (*desc.CollectKnobsResponse, error) {
+func toDescKnobs(knobs []model.Knob) ([]*desc.CollectKnobsResponse_Knob, error) {
 	var err error
 
 	descKnobs := lo.Map(knobs, func(knob model.Knob, _ int) *desc.CollectKnobsResponse_Knob {
 		protoKnob := &desc.CollectKnobsResponse_Knob{
-			Name: knob.Name,
+			Name:       knob.Name,
+			Vartype:    knob.VarType,
+			EnumValues: knob.EnumVals,
+			Unit:       knob.Unit,
+
+			Context:        knob.Context,
+			PendingRestart: knob.PendingRestart,
+			Source:         knob.Source,
 		}
 
 		switch v := knob.Value.(type) {
 		case string:
 			protoKnob.Value = &desc.CollectKnobsResponse_Knob_StrValue{StrValue: v}
 		case float64:
-			protoKnob.Value = &desc.CollectKnobsResponse_Knob_FloatValue{FloatValue: float32(v)}
-			protoKnob.MinValue = float32(knob.MinVal.(float64))
-			protoKnob.MaxValue = float32(knob.MaxVal.(float64))
+			protoKnob.Value = &desc.CollectKnobsResponse_Knob_FloatValue{FloatValue: v}
+			if minVal, ok := knob.MinVal.(float64); ok {
+				protoKnob.MinValue = minVal
+			}
+			if maxVal, ok := knob.MaxVal.(float64); ok {
+				protoKnob.MaxValue = maxVal
+			}
 		case bool:
 			protoKnob.Value = &desc.CollectKnobsResponse_Knob_BoolValue{BoolValue: v}
 		default:
@@ -78,7 +139,7 @@ func toDescKnobs(knobs []model.Knob) (*desc.CollectKnobsResponse, error) {
 		return nil, err
 	}
 
-	return &desc.CollectKnobsResponse{Knobs: descKnobs}, nil
+	return descKnobs, nil
 }
 
 func (d *Delivery) InitLoad(ctx context.Context, _ *desc.InitLoadRequest) (*desc.InitLoadResponse, error) {
@@ -95,45 +156,148 @@ func (d *Delivery) SetKnobs(ctx context.Context, req *desc.SetKnobsRequest) (*de
 		return nil, status.Error(codes.InvalidArgument, "knobs should be specified")
 	}
 
-	var foundEmptyKnob bool
+	var foundEmptyKnob, foundEmptyValue bool
 	lo.ForEach(knobs, func(knob *desc.SetKnobsRequest_Knob, _ int) {
 		if knob.Name == "" {
 			foundEmptyKnob = true
 		}
+		if knob.Value == nil {
+			foundEmptyValue = true
+		}
 	})
 	if foundEmptyKnob {
 		return nil, status.Error(codes.InvalidArgument, "knob name should be specified")
 	}
+	if foundEmptyValue {
+		return nil, status.Error(codes.InvalidArgument, "knob value should be specified")
+	}
 
 	modelKnobs := lo.Map(knobs, func(knob *desc.SetKnobsRequest_Knob, index int) model.Knob {
 		return model.Knob{
 			Name:  knob.Name,
-			Value: knob.Value,
+			Value: fromDescKnobValue(knob),
 		}
 	})
 
-	err := d.setter.SetKnobs(ctx, modelKnobs)
+	results, err := d.setter.SetKnobs(ctx, modelKnobs)
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidKnob) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, model.ErrKnobForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, fmt.Errorf("setter.SetKnobs: %w", err)
 	}
-	return &desc.SetKnobsResponse{}, nil
+
+	return &desc.SetKnobsResponse{Knobs: toDescKnobApplyResults(results)}, nil
+}
+
+func toDescKnobApplyResults(results []model.KnobApplyResult) []*desc.SetKnobsResponse_Knob {
+	return lo.Map(results, func(result model.KnobApplyResult, _ int) *desc.SetKnobsResponse_Knob {
+		return &desc.SetKnobsResponse_Knob{
+			Name:           result.Name,
+			RequestedValue: toDescKnobValue(result.RequestedValue),
+			EffectiveValue: toDescKnobValue(result.EffectiveValue),
+			Status:         toDescKnobApplyStatus(result.Status),
+			Error:          result.Error,
+		}
+	})
+}
+
+func toDescKnobValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		if v {
+			return "on"
+		}
+		return "off"
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toDescKnobApplyStatus(applyStatus model.KnobApplyStatus) desc.KnobApplyStatus {
+	switch applyStatus {
+	case model.KnobApplied:
+		return desc.KnobApplyStatus_Applied
+	case model.KnobPendingRestart:
+		return desc.KnobApplyStatus_PendingRestart
+	case model.KnobRejected:
+		return desc.KnobApplyStatus_Rejected
+	default:
+		return desc.KnobApplyStatus_Unspecified
+	}
 }
 
-func (d *Delivery) CollectExternalMetrics(ctx context.Context, _ *desc.CollectExternalMetricsRequest) (*desc.CollectExternalMetricsResponse, error) {
-	metricsCh, errCh := d.loader.RunLoad(ctx)
+func fromDescKnobValue(knob *desc.SetKnobsRequest_Knob) interface{} {
+	switch v := knob.Value.(type) {
+	case *desc.SetKnobsRequest_Knob_FloatValue:
+		return v.FloatValue
+	case *desc.SetKnobsRequest_Knob_StrValue:
+		return v.StrValue
+	case *desc.SetKnobsRequest_Knob_BoolValue:
+		return v.BoolValue
+	default:
+		return nil
+	}
+}
+
+func (d *Delivery) CollectExternalMetrics(ctx context.Context, req *desc.CollectExternalMetricsRequest) (*desc.CollectExternalMetricsResponse, error) {
+	metricsCh, errCh := d.loader.RunLoad(ctx, fromDescWorkload(req))
 	select {
 	case metrics := <-metricsCh:
 		return &desc.CollectExternalMetricsResponse{
-			Tps:     float32(metrics.Tps),
-			Latency: float32(metrics.Latency),
+			Tps:          float32(metrics.Tps),
+			Latency:      float32(metrics.Latency),
+			LatencyP50:   float32(metrics.LatencyP50),
+			LatencyP95:   float32(metrics.LatencyP95),
+			LatencyP99:   float32(metrics.LatencyP99),
+			Transactions: uint64(metrics.Transactions),
+			Errors:       uint64(metrics.Errors),
 		}, nil
 	case err := <-errCh:
+		if errors.Is(err, model.ErrInvalidWorkload) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, fmt.Errorf("loader.RunLoad: %w", err)
 	}
 }
 
-func (d *Delivery) CollectInternalMetrics(ctx context.Context, _ *desc.CollectInternalMetricsRequest) (*desc.CollectInternalMetricsResponse, error) {
-	metrics, err := d.selector.ListAllAggregatedMetrics(ctx)
+func fromDescWorkload(req *desc.CollectExternalMetricsRequest) model.Workload {
+	toScripts := func(scripts []*desc.CollectExternalMetricsRequest_Script) []model.WorkloadScript {
+		return lo.Map(scripts, func(script *desc.CollectExternalMetricsRequest_Script, _ int) model.WorkloadScript {
+			return model.WorkloadScript{Name: script.GetName(), Weight: int64(script.GetWeight())}
+		})
+	}
+
+	return model.Workload{
+		Builtins:  toScripts(req.GetBuiltins()),
+		Scripts:   toScripts(req.GetScripts()),
+		QueryMode: req.GetQueryMode(),
+		Rate:      req.GetRate(),
+		Variables: req.GetVariables(),
+	}
+}
+
+func (d *Delivery) CollectInternalMetrics(ctx context.Context, req *desc.CollectInternalMetricsRequest) (*desc.CollectInternalMetricsResponse, error) {
+	if req.GetWindowSeconds() > maxWindowSeconds {
+		return nil, status.Errorf(codes.InvalidArgument, "window_seconds should not exceed %d", maxWindowSeconds)
+	}
+	window := time.Duration(req.GetWindowSeconds()) * time.Second
+
+	client := req.GetClientId()
+	if client == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			client = p.Addr.String()
+		}
+	}
+
+	metrics, interval, err := d.selector.ListAllAggregatedMetrics(ctx, fromDescMetricsMode(req.GetMode()), window, client)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +306,29 @@ func (d *Delivery) CollectInternalMetrics(ctx context.Context, _ *desc.CollectIn
 		return toDescMetric(metric)
 	})
 
-	return &desc.CollectInternalMetricsResponse{Metrics: protoMetrics}, nil
+	return &desc.CollectInternalMetricsResponse{
+		Metrics:         protoMetrics,
+		IntervalSeconds: float32(interval.Seconds()),
+	}, nil
+}
+
+func fromDescMetricsMode(mode desc.MetricsMode) model.MetricsMode {
+	switch mode {
+	case desc.MetricsMode_Delta:
+		return model.MetricsModeDelta
+	case desc.MetricsMode_Rate:
+		return model.MetricsModeRate
+	default:
+		return model.MetricsModeRaw
+	}
 }
 
 func toDescMetric(metric model.InternalMetric) *desc.CollectInternalMetricsResponse_Metric {
 	protoMetric := &desc.CollectInternalMetricsResponse_Metric{
-		Name:  metric.Name,
-		Scope: metric.Scope.String(),
+		Name:         metric.Name,
+		Scope:        metric.Scope.String(),
+		Counter:      metric.Counter,
+		CounterReset: metric.CounterReset,
 	}
 
 	switch v := metric.Value.(type) {
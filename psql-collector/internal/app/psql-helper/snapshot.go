@@ -0,0 +1,85 @@
+package psql_helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+)
+
+func (d *Delivery) CreateKnobSnapshot(ctx context.Context, req *desc.CreateKnobSnapshotRequest) (*desc.CreateKnobSnapshotResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "snapshot name should not be empty")
+	}
+
+	snapshot, err := d.snapshotter.CreateSnapshot(ctx, req.GetName())
+	if err != nil {
+		if errors.Is(err, model.ErrSnapshotAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, fmt.Errorf("snapshotter.CreateSnapshot: %w", err)
+	}
+
+	descSnapshot, err := toDescKnobSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.CreateKnobSnapshotResponse{Snapshot: descSnapshot}, nil
+}
+
+func (d *Delivery) ListKnobSnapshots(ctx context.Context, _ *desc.ListKnobSnapshotsRequest) (*desc.ListKnobSnapshotsResponse, error) {
+	snapshots := d.snapshotter.ListSnapshots(ctx)
+
+	descSnapshots := make([]*desc.KnobSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		descSnapshot, err := toDescKnobSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		descSnapshots = append(descSnapshots, descSnapshot)
+	}
+	return &desc.ListKnobSnapshotsResponse{Snapshots: descSnapshots}, nil
+}
+
+func (d *Delivery) RestoreKnobSnapshot(ctx context.Context, req *desc.RestoreKnobSnapshotRequest) (*desc.RestoreKnobSnapshotResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "snapshot name should not be empty")
+	}
+
+	results, err := d.snapshotter.RestoreSnapshot(ctx, req.GetName())
+	if err != nil {
+		if errors.Is(err, model.ErrSnapshotNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, model.ErrInvalidKnob) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("snapshotter.RestoreSnapshot: %w", err)
+	}
+
+	return &desc.RestoreKnobSnapshotResponse{Knobs: toDescKnobApplyResults(results)}, nil
+}
+
+func (d *Delivery) ResetKnobs(ctx context.Context, _ *desc.ResetKnobsRequest) (*desc.ResetKnobsResponse, error) {
+	pendingRestart, err := d.snapshotter.ResetKnobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("snapshotter.ResetKnobs: %w", err)
+	}
+	return &desc.ResetKnobsResponse{PendingRestart: pendingRestart}, nil
+}
+
+func toDescKnobSnapshot(snapshot model.KnobSnapshot) (*desc.KnobSnapshot, error) {
+	knobs, err := toDescKnobs(snapshot.Knobs)
+	if err != nil {
+		return nil, err
+	}
+	return &desc.KnobSnapshot{
+		Name:      snapshot.Name,
+		CreatedAt: timestamppb.New(snapshot.CreatedAt),
+		Knobs:     knobs,
+	}, nil
+}
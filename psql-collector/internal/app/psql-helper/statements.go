@@ -0,0 +1,60 @@
+package psql_helper
+
+import (
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+)
+
+func (d *Delivery) CollectTopStatements(ctx context.Context, req *desc.CollectTopStatementsRequest) (*desc.CollectTopStatementsResponse, error) {
+	stats, err := d.selector.ListTopStatements(ctx, int(req.GetLimit()), fromDescStatementsOrderBy(req.GetOrderBy()))
+	if err != nil {
+		return nil, fmt.Errorf("selector.ListTopStatements: %w", err)
+	}
+
+	statements := lo.Map(stats, func(stat model.StatementStat, _ int) *desc.CollectTopStatementsResponse_Statement {
+		return &desc.CollectTopStatementsResponse_Statement{
+			QueryId:           stat.QueryID,
+			Query:             stat.Query,
+			Calls:             stat.Calls,
+			TotalExecTime:     stat.TotalExecTime,
+			MeanExecTime:      stat.MeanExecTime,
+			StddevExecTime:    stat.StddevExecTime,
+			Rows:              stat.Rows,
+			SharedBlksHit:     stat.SharedBlksHit,
+			SharedBlksRead:    stat.SharedBlksRead,
+			SharedBlksDirtied: stat.SharedBlksDirtied,
+			SharedBlksWritten: stat.SharedBlksWritten,
+			LocalBlksHit:      stat.LocalBlksHit,
+			LocalBlksRead:     stat.LocalBlksRead,
+			LocalBlksDirtied:  stat.LocalBlksDirtied,
+			LocalBlksWritten:  stat.LocalBlksWritten,
+			TempBlksRead:      stat.TempBlksRead,
+			TempBlksWritten:   stat.TempBlksWritten,
+			WalBytes:          stat.WalBytes,
+		}
+	})
+
+	return &desc.CollectTopStatementsResponse{Statements: statements}, nil
+}
+
+func fromDescStatementsOrderBy(orderBy desc.StatementsOrderBy) model.StatementsOrder {
+	switch orderBy {
+	case desc.StatementsOrderBy_Calls:
+		return model.OrderByCalls
+	case desc.StatementsOrderBy_MeanExecTime:
+		return model.OrderByMeanExecTime
+	case desc.StatementsOrderBy_Rows:
+		return model.OrderByRows
+	case desc.StatementsOrderBy_SharedBlksRead:
+		return model.OrderBySharedBlksRead
+	case desc.StatementsOrderBy_TempBlksWritten:
+		return model.OrderByTempBlksWritten
+	case desc.StatementsOrderBy_WalBytes:
+		return model.OrderByWalBytes
+	default:
+		return model.OrderByTotalExecTime
+	}
+}
@@ -0,0 +1,72 @@
+package psql_helper
+
+import (
+	"errors"
+	"fmt"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+	"time"
+)
+
+func (d *Delivery) StreamInternalMetrics(req *desc.StreamInternalMetricsRequest, stream desc.Collector_StreamInternalMetricsServer) error {
+	groups := make([]model.MetricGroup, 0, len(req.GetGroups()))
+	for _, descGroup := range req.GetGroups() {
+		group := fromDescMetricGroup(descGroup)
+		if group == model.MetricGroupUnspecified {
+			return status.Error(codes.InvalidArgument, "metric group should be specified")
+		}
+		groups = append(groups, group)
+	}
+
+	ctx := stream.Context()
+	interval := time.Duration(req.GetIntervalSeconds()) * time.Second
+	snapshots, err := d.selector.SubscribeAggregatedMetrics(ctx, interval, groups, fromDescMetricsMode(req.GetMode()))
+	if err != nil {
+		if errors.Is(err, model.ErrMetricGroupNotSampled) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return fmt.Errorf("selector.SubscribeAggregatedMetrics: %w", err)
+	}
+
+	for snapshot := range snapshots {
+		if snapshot.Err != nil {
+			return status.Errorf(codes.Unavailable, "collect metrics: %v", snapshot.Err)
+		}
+
+		err := stream.Send(&desc.StreamInternalMetricsResponse{
+			TakenAt: timestamppb.New(snapshot.TakenAt),
+			Metrics: lo.Map(snapshot.Metrics, func(metric model.InternalMetric, _ int) *desc.CollectInternalMetricsResponse_Metric {
+				return toDescMetric(metric)
+			}),
+			IntervalSeconds: float32(snapshot.Interval.Seconds()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return status.FromContextError(ctx.Err()).Err()
+}
+
+func fromDescMetricGroup(group desc.MetricGroup) model.MetricGroup {
+	switch group {
+	case desc.MetricGroup_Activity:
+		return model.MetricGroupActivity
+	case desc.MetricGroup_Locks:
+		return model.MetricGroupLocks
+	case desc.MetricGroup_Replication:
+		return model.MetricGroupReplication
+	case desc.MetricGroup_Autovacuum:
+		return model.MetricGroupAutovacuum
+	case desc.MetricGroup_Bloat:
+		return model.MetricGroupBloat
+	case desc.MetricGroup_Tables:
+		return model.MetricGroupTables
+	default:
+		return model.MetricGroupUnspecified
+	}
+}
@@ -0,0 +1,35 @@
+package psql_helper
+
+import (
+	"context"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgresHelper/internal/model"
+	desc "postgresHelper/pkg/collector"
+	"time"
+)
+
+func (d *Delivery) CollectWaitEvents(ctx context.Context, req *desc.CollectWaitEventsRequest) (*desc.CollectWaitEventsResponse, error) {
+	window := time.Duration(req.GetWindowSeconds()) * time.Second
+	histogram := d.selector.ListWaitEvents(ctx, window)
+
+	events := lo.Map(histogram.Events, func(event model.WaitEventCount, _ int) *desc.CollectWaitEventsResponse_Event {
+		descEvent := &desc.CollectWaitEventsResponse_Event{
+			WaitEventType: event.WaitEventType,
+			WaitEvent:     event.WaitEvent,
+			Count:         event.Count,
+		}
+		if histogram.Sessions > 0 {
+			descEvent.Fraction = float64(event.Count) / float64(histogram.Sessions)
+		}
+		return descEvent
+	})
+
+	return &desc.CollectWaitEventsResponse{
+		From:     timestamppb.New(histogram.From),
+		To:       timestamppb.New(histogram.To),
+		Samples:  histogram.Samples,
+		Sessions: histogram.Sessions,
+		Events:   events,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package autovacuum
+
+import (
+	"strings"
+
+	"postgresHelper/internal/model"
+)
+
+// Aggregate summarizes the autovacuum state for the tuning state.
+func Aggregate(stats VacuumStats) model.AutovacuumStat {
+	var aggr model.AutovacuumStat
+
+	if stats.AutovacuumEnabled {
+		aggr.AutovacuumEnabled = 1
+	}
+
+	var liveRows, deadRows int64
+	for _, relation := range stats.Relations {
+		liveRows += relation.LiveRowCount
+		deadRows += relation.DeadRowCount
+
+		if relation.NeedsVacuum() {
+			aggr.TablesNeedingVacuum++
+		}
+		if relation.NeedsAnalyze() {
+			aggr.TablesNeedingAnalyze++
+		}
+		if !relation.AutovacuumEnabled {
+			aggr.TablesWithAutovacuumDisabled++
+		}
+		if hasAutovacuumOverrides(relation) {
+			aggr.TablesWithAutovacuumOverrides++
+		}
+
+		if total := relation.LiveRowCount + relation.DeadRowCount; total > 0 {
+			aggr.MaxDeadRowsRatio = max(aggr.MaxDeadRowsRatio, float64(relation.DeadRowCount)/float64(total))
+		}
+		aggr.MaxXidAge = max(aggr.MaxXidAge, float64(relation.Relfrozenxid))
+		if relation.AutovacuumFreezeMaxAge > 0 {
+			aggr.MaxXidAgeRatio = max(aggr.MaxXidAgeRatio, float64(relation.Relfrozenxid)/float64(relation.AutovacuumFreezeMaxAge))
+		}
+		aggr.MaxMultixactAge = max(aggr.MaxMultixactAge, float64(relation.Relminmxid))
+	}
+	if liveRows+deadRows > 0 {
+		aggr.DeadRowsRatio = float64(deadRows) / float64(liveRows+deadRows)
+	}
+
+	for _, transaction := range stats.LongRunningTransactions {
+		aggr.LongRunningTransactions++
+		aggr.LongestTransactionTime = max(aggr.LongestTransactionTime, transaction.Duration)
+	}
+
+	return aggr
+}
+
+func hasAutovacuumOverrides(relation VacuumStatsEntry) bool {
+	for name := range relation.Reloptions {
+		if strings.HasPrefix(name, "autovacuum_") {
+			return true
+		}
+	}
+	return false
+}
@@ -5,12 +5,32 @@ import (
 	"database/sql"
 	"fmt"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type Helper interface {
 	IsEnabled(ctx context.Context) (bool, error)
 	ReadCurrentAutovacuumSettings(ctx context.Context) (VacuumStats, error)
-	FindLongRunningTransactions(ctx context.Context)
+	ReadRelations(ctx context.Context, settings VacuumStats) ([]VacuumStatsEntry, error)
+	FindLongRunningTransactions(ctx context.Context, olderThan time.Duration) ([]LongRunningTransaction, error)
+	CollectAutovacuumState(ctx context.Context, longTransaction time.Duration) (VacuumStats, error)
+}
+
+// autovacuumSettings are read by name, pg_settings categories differ between server versions
+// and autovacuum_work_mem belongs to the resource usage one.
+var autovacuumSettings = []string{
+	"autovacuum_vacuum_cost_limit",
+	"autovacuum_vacuum_cost_delay",
+	"autovacuum_work_mem",
+	"autovacuum_max_workers",
+	"autovacuum_vacuum_threshold",
+	"autovacuum_vacuum_scale_factor",
+	"autovacuum_analyze_threshold",
+	"autovacuum_analyze_scale_factor",
+	"autovacuum_freeze_max_age",
+	"autovacuum_multixact_freeze_max_age",
+	"autovacuum_naptime",
 }
 
 type Impl struct {
@@ -56,9 +76,9 @@ func (i *Impl) ReadCurrentAutovacuumSettings(ctx context.Context) (VacuumStats,
 	defer cancel()
 
 	query := `
-	SELECT name, setting FROM pg_settings WHERE category = 'Autovacuum' and name != 'autovacuum';
+	SELECT name, setting FROM pg_settings WHERE name = ANY ($1);
 `
-	rows, err := i.db.QueryContext(ctx, query)
+	rows, err := i.db.QueryContext(ctx, query, pq.Array(autovacuumSettings))
 	if err != nil {
 		return VacuumStats{}, fmt.Errorf("i.db.QueryContext: %v", err)
 	}
@@ -76,3 +96,139 @@ func (i *Impl) ReadCurrentAutovacuumSettings(ctx context.Context) (VacuumStats,
 	}
 	return ToVacuumStats(settings)
 }
+
+// ReadRelations returns vacuum statistics of user tables with settings taken from reloptions,
+// or from the database settings when a table does not override them. Partitioned tables hold
+// no rows and have no frozen xid, they are left out and their partitions are reported instead.
+func (i *Impl) ReadRelations(ctx context.Context, settings VacuumStats) ([]VacuumStatsEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.queryTimout)
+	defer cancel()
+
+	query := `
+	SELECT
+		s.relid,
+		s.schemaname,
+		s.relname,
+		s.n_live_tup,
+		s.n_dead_tup,
+		s.n_mod_since_analyze,
+		age(c.relfrozenxid),
+		mxid_age(c.relminmxid),
+		s.last_vacuum,
+		s.last_autovacuum,
+		s.last_analyze,
+		s.last_autoanalyze,
+		coalesce(array_to_string(c.reloptions, ','), '')
+	FROM pg_stat_user_tables s
+		JOIN pg_class c ON c.oid = s.relid
+	WHERE c.relkind <> 'p'
+	ORDER BY s.schemaname, s.relname;
+`
+	rows, err := i.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %v", err)
+	}
+	defer rows.Close()
+
+	var relations []VacuumStatsEntry
+	for rows.Next() {
+		t := RelationDto{}
+		err := rows.Scan(
+			&t.relationID,
+			&t.schemaName,
+			&t.relationName,
+			&t.liveRowCount,
+			&t.deadRowCount,
+			&t.modifiedSinceAnalyze,
+			&t.relfrozenxidAge,
+			&t.relminmxidAge,
+			&t.lastVacuum,
+			&t.lastAutoVacuum,
+			&t.lastAnalyze,
+			&t.lastAutoAnalyze,
+			&t.reloptions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %v", err)
+		}
+
+		relations = append(relations, ToVacuumStatsEntry(t, settings))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %v", err)
+	}
+	return relations, nil
+}
+
+// FindLongRunningTransactions returns backends with a transaction open for longer than olderThan, oldest first.
+func (i *Impl) FindLongRunningTransactions(ctx context.Context, olderThan time.Duration) ([]LongRunningTransaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.queryTimout)
+	defer cancel()
+
+	query := `
+	SELECT
+		pid,
+		coalesce(usename, ''),
+		coalesce(state, ''),
+		coalesce(query, ''),
+		xact_start,
+		extract(epoch FROM now() - xact_start) * 1000,
+		coalesce(age(backend_xmin), 0)
+	FROM pg_stat_activity
+	WHERE xact_start IS NOT NULL
+		AND now() - xact_start > $1 * interval '1 millisecond'
+		AND pid <> pg_backend_pid()
+	ORDER BY xact_start;
+`
+	rows, err := i.db.QueryContext(ctx, query, olderThan.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %v", err)
+	}
+	defer rows.Close()
+
+	var transactions []LongRunningTransaction
+	for rows.Next() {
+		t := LongRunningTransaction{}
+		err := rows.Scan(&t.Pid, &t.User, &t.State, &t.Query, &t.TransactionStart, &t.Duration, &t.BackendXminAge)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %v", err)
+		}
+
+		transactions = append(transactions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %v", err)
+	}
+	return transactions, nil
+}
+
+// CollectAutovacuumState reads database settings, per-relation statistics
+// and transactions open for longer than longTransaction.
+func (i *Impl) CollectAutovacuumState(ctx context.Context, longTransaction time.Duration) (VacuumStats, error) {
+	stats, err := i.ReadCurrentAutovacuumSettings(ctx)
+	if err != nil {
+		return VacuumStats{}, fmt.Errorf("i.ReadCurrentAutovacuumSettings: %v", err)
+	}
+
+	stats.AutovacuumEnabled, err = i.IsEnabled(ctx)
+	if err != nil {
+		return VacuumStats{}, fmt.Errorf("i.IsEnabled: %v", err)
+	}
+
+	err = i.db.QueryRowContext(ctx, `SELECT current_database();`).Scan(&stats.DatabaseName)
+	if err != nil {
+		return VacuumStats{}, fmt.Errorf("row.Scan: %v", err)
+	}
+
+	stats.Relations, err = i.ReadRelations(ctx, stats)
+	if err != nil {
+		return VacuumStats{}, fmt.Errorf("i.ReadRelations: %v", err)
+	}
+
+	stats.LongRunningTransactions, err = i.FindLongRunningTransactions(ctx, longTransaction)
+	if err != nil {
+		return VacuumStats{}, fmt.Errorf("i.FindLongRunningTransactions: %v", err)
+	}
+
+	return stats, nil
+}
@@ -1,7 +1,10 @@
 package autovacuum
 
 import (
+	"database/sql"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type SettingDto struct {
@@ -19,7 +22,8 @@ func ToVacuumStats(dtos []SettingDto) (VacuumStats, error) {
 			costLimit, _ := strconv.ParseInt(d.setting, 10, 32)
 			s.AutovacuumVacuumCostLimit = int32(costLimit)
 		case "autovacuum_vacuum_cost_delay":
-			val, _ := strconv.ParseInt(d.setting, 10, 32)
+			// milliseconds with a fractional part since PostgreSQL 12
+			val, _ := strconv.ParseFloat(d.setting, 64)
 			s.AutovacuumVacuumCostDelay = int32(val)
 		case "autovacuum_work_mem":
 			val, _ := strconv.ParseInt(d.setting, 10, 32)
@@ -52,3 +56,106 @@ func ToVacuumStats(dtos []SettingDto) (VacuumStats, error) {
 	}
 	return s, nil
 }
+
+type RelationDto struct {
+	relationID           int64
+	schemaName           string
+	relationName         string
+	liveRowCount         int64
+	deadRowCount         int64
+	modifiedSinceAnalyze int64
+	relfrozenxidAge      int32
+	relminmxidAge        int32
+	lastVacuum           sql.Null[time.Time]
+	lastAutoVacuum       sql.Null[time.Time]
+	lastAnalyze          sql.Null[time.Time]
+	lastAutoAnalyze      sql.Null[time.Time]
+	// reloptions is pg_class.reloptions joined with commas
+	reloptions string
+}
+
+// defaultFillfactor is the fillfactor of tables without the storage parameter.
+const defaultFillfactor = 100
+
+// ToVacuumStatsEntry applies per-table reloptions over the database settings in s.
+func ToVacuumStatsEntry(d RelationDto, s VacuumStats) VacuumStatsEntry {
+	e := VacuumStatsEntry{
+		RelationID:           d.relationID,
+		SchemaName:           d.schemaName,
+		RelationName:         d.relationName,
+		LiveRowCount:         d.liveRowCount,
+		DeadRowCount:         d.deadRowCount,
+		ModifiedSinceAnalyze: d.modifiedSinceAnalyze,
+		Relfrozenxid:         d.relfrozenxidAge,
+		Relminmxid:           d.relminmxidAge,
+		LastManualVacuumRun:  d.lastVacuum,
+		LastAutoVacuumRun:    d.lastAutoVacuum,
+		LastManualAnalyzeRun: d.lastAnalyze,
+		LastAutoAnalyzeRun:   d.lastAutoAnalyze,
+
+		AutovacuumEnabled:               s.AutovacuumEnabled,
+		AutovacuumVacuumThreshold:       s.AutovacuumVacuumThreshold,
+		AutovacuumAnalyzeThreshold:      s.AutovacuumAnalyzeThreshold,
+		AutovacuumVacuumScaleFactor:     s.AutovacuumVacuumScaleFactor,
+		AutovacuumAnalyzeScaleFactor:    s.AutovacuumAnalyzeScaleFactor,
+		AutovacuumFreezeMaxAge:          s.AutovacuumFreezeMaxAge,
+		AutovacuumMultixactFreezeMaxAge: s.AutovacuumMultixactFreezeMaxAge,
+		AutovacuumVacuumCostDelay:       s.AutovacuumVacuumCostDelay,
+		AutovacuumVacuumCostLimit:       s.AutovacuumVacuumCostLimit,
+
+		Fillfactor: defaultFillfactor,
+		Reloptions: parseReloptions(d.reloptions),
+	}
+
+	for name, value := range e.Reloptions {
+		switch name {
+		case "autovacuum_enabled":
+			val, err := strconv.ParseBool(value)
+			if err == nil {
+				// autovacuum disabled in the database cannot be enabled per table
+				e.AutovacuumEnabled = s.AutovacuumEnabled && val
+			}
+		case "autovacuum_vacuum_threshold":
+			val, _ := strconv.ParseInt(value, 10, 32)
+			e.AutovacuumVacuumThreshold = int32(val)
+		case "autovacuum_analyze_threshold":
+			val, _ := strconv.ParseInt(value, 10, 32)
+			e.AutovacuumAnalyzeThreshold = int32(val)
+		case "autovacuum_vacuum_scale_factor":
+			val, _ := strconv.ParseFloat(value, 64)
+			e.AutovacuumVacuumScaleFactor = val
+		case "autovacuum_analyze_scale_factor":
+			val, _ := strconv.ParseFloat(value, 64)
+			e.AutovacuumAnalyzeScaleFactor = val
+		case "autovacuum_freeze_max_age":
+			// the table setting is ignored when it is larger than the database one
+			val, _ := strconv.ParseInt(value, 10, 32)
+			e.AutovacuumFreezeMaxAge = min(int32(val), s.AutovacuumFreezeMaxAge)
+		case "autovacuum_multixact_freeze_max_age":
+			val, _ := strconv.ParseInt(value, 10, 32)
+			e.AutovacuumMultixactFreezeMaxAge = min(int32(val), s.AutovacuumMultixactFreezeMaxAge)
+		case "autovacuum_vacuum_cost_delay":
+			val, _ := strconv.ParseFloat(value, 64)
+			e.AutovacuumVacuumCostDelay = int32(val)
+		case "autovacuum_vacuum_cost_limit":
+			val, _ := strconv.ParseInt(value, 10, 32)
+			e.AutovacuumVacuumCostLimit = int32(val)
+		case "fillfactor":
+			val, _ := strconv.ParseInt(value, 10, 32)
+			e.Fillfactor = int32(val)
+		}
+	}
+	return e
+}
+
+func parseReloptions(reloptions string) map[string]string {
+	options := make(map[string]string)
+	if reloptions == "" {
+		return options
+	}
+	for _, option := range strings.Split(reloptions, ",") {
+		name, value, _ := strings.Cut(option, "=")
+		options[name] = value
+	}
+	return options
+}
@@ -6,11 +6,14 @@ import (
 )
 
 type VacuumStatsEntry struct {
+	RelationID   int64
 	SchemaName   string
 	RelationName string
 
-	LiveRowCount int32
-	DeadRowCount int32
+	LiveRowCount         int64
+	DeadRowCount         int64
+	ModifiedSinceAnalyze int64
+	// Relfrozenxid and Relminmxid are ages of pg_class.relfrozenxid and relminmxid
 	Relfrozenxid int32
 	Relminmxid   int32
 
@@ -19,6 +22,7 @@ type VacuumStatsEntry struct {
 	LastManualAnalyzeRun sql.Null[time.Time]
 	LastAutoAnalyzeRun   sql.Null[time.Time]
 
+	// Autovacuum settings in effect for the relation: database settings overridden by reloptions
 	AutovacuumEnabled               bool
 	AutovacuumVacuumThreshold       int32
 	AutovacuumAnalyzeThreshold      int32
@@ -30,6 +34,36 @@ type VacuumStatsEntry struct {
 	AutovacuumVacuumCostLimit       int32
 
 	Fillfactor int32
+
+	// Reloptions are the storage parameters set on the relation, e.g. autovacuum_enabled or fillfactor
+	Reloptions map[string]string
+}
+
+// NeedsVacuum reports whether dead rows exceed the autovacuum vacuum threshold.
+func (e VacuumStatsEntry) NeedsVacuum() bool {
+	threshold := float64(e.AutovacuumVacuumThreshold) + e.AutovacuumVacuumScaleFactor*float64(e.LiveRowCount)
+	return float64(e.DeadRowCount) > threshold
+}
+
+// NeedsAnalyze reports whether rows modified since the last analyze exceed the autovacuum analyze threshold.
+func (e VacuumStatsEntry) NeedsAnalyze() bool {
+	threshold := float64(e.AutovacuumAnalyzeThreshold) + e.AutovacuumAnalyzeScaleFactor*float64(e.LiveRowCount)
+	return float64(e.ModifiedSinceAnalyze) > threshold
+}
+
+// LongRunningTransaction is a backend with a transaction open longer than requested.
+// Old transactions hold back the xmin horizon, so vacuum cannot remove dead rows.
+type LongRunningTransaction struct {
+	Pid   int64
+	User  string
+	State string
+	Query string
+
+	TransactionStart time.Time
+	// Duration is in milliseconds
+	Duration float64
+	// BackendXminAge is the age of the oldest snapshot of the backend
+	BackendXminAge int64
 }
 
 type VacuumStats struct {
@@ -49,5 +83,6 @@ type VacuumStats struct {
 	AutovacuumVacuumCostDelay       int32
 	AutovacuumVacuumCostLimit       int32
 
-	Relations []VacuumStatsEntry
+	Relations               []VacuumStatsEntry
+	LongRunningTransactions []LongRunningTransaction
 }
@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"postgresHelper/internal/model"
+)
+
+func (i *Implementation) CollectLockWaits(ctx context.Context) ([]model.LockWait, model.Scope, error) {
+	rows, err := i.db.QueryContext(ctx, i.queries[queryLockingInformation])
+	if err != nil {
+		return nil, model.Unspecified, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var waits []model.LockWait
+	for rows.Next() {
+		var wait model.LockWait
+		err := rows.Scan(
+			&wait.BlockedPid,
+			&wait.BlockedUser,
+			&wait.BlockedQuery,
+			&wait.BlockedState,
+			&wait.LockMode,
+			&wait.LockType,
+			&wait.Relation,
+			&wait.WaitTime,
+			&wait.BlockingPid,
+			&wait.BlockingUser,
+			&wait.BlockingQuery,
+			&wait.BlockingState,
+		)
+		if err != nil {
+			return nil, model.Unspecified, fmt.Errorf("rows.Scan: %w", err)
+		}
+		waits = append(waits, wait)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, model.Unspecified, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return waits, model.General, nil
+}
+
+// CollectSessionWaits returns what non-idle client backends are waiting on right now.
+func (i *Implementation) CollectSessionWaits(ctx context.Context) ([]model.SessionWait, error) {
+	rows, err := i.db.QueryContext(ctx, i.queries[querySessionWaits])
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []model.SessionWait
+	for rows.Next() {
+		var session model.SessionWait
+		err := rows.Scan(&session.WaitEventType, &session.WaitEvent, &session.State)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return sessions, nil
+}
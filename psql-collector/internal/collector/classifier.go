@@ -0,0 +1,321 @@
+package collector
+
+import (
+	"strings"
+	"unicode"
+)
+
+// queryKind is the statement type pg_stat_statements entries are grouped by.
+type queryKind int
+
+const (
+	kindOther queryKind = iota
+	kindSelect
+	kindInsert
+	kindUpdate
+	kindDelete
+	kindCopyFrom
+	kindCopyTo
+	kindDDL
+	kindUtility
+)
+
+func (k queryKind) isRead() bool {
+	return k == kindSelect || k == kindCopyTo
+}
+
+func (k queryKind) isWrite() bool {
+	switch k {
+	case kindInsert, kindUpdate, kindDelete, kindCopyFrom:
+		return true
+	default:
+		return false
+	}
+}
+
+var leadingKeywordKinds = map[string]queryKind{
+	"SELECT": kindSelect,
+	"VALUES": kindSelect,
+	"TABLE":  kindSelect,
+	"INSERT": kindInsert,
+	"UPDATE": kindUpdate,
+	// MERGE may insert, update and delete rows, it is counted as update
+	"MERGE":  kindUpdate,
+	"DELETE": kindDelete,
+	// TRUNCATE modifies data like DELETE, as log_statement = mod treats it
+	"TRUNCATE": kindDelete,
+
+	"CREATE":   kindDDL,
+	"ALTER":    kindDDL,
+	"DROP":     kindDDL,
+	"COMMENT":  kindDDL,
+	"GRANT":    kindDDL,
+	"REVOKE":   kindDDL,
+	"SECURITY": kindDDL,
+	"IMPORT":   kindDDL,
+	"REFRESH":  kindDDL,
+
+	"VACUUM":     kindUtility,
+	"ANALYZE":    kindUtility,
+	"ANALYSE":    kindUtility,
+	"CLUSTER":    kindUtility,
+	"REINDEX":    kindUtility,
+	"CHECKPOINT": kindUtility,
+	"EXPLAIN":    kindUtility,
+	"SET":        kindUtility,
+	"RESET":      kindUtility,
+	"SHOW":       kindUtility,
+	"BEGIN":      kindUtility,
+	"START":      kindUtility,
+	"COMMIT":     kindUtility,
+	"END":        kindUtility,
+	"ROLLBACK":   kindUtility,
+	"ABORT":      kindUtility,
+	"SAVEPOINT":  kindUtility,
+	"RELEASE":    kindUtility,
+	"PREPARE":    kindUtility,
+	"EXECUTE":    kindUtility,
+	"DEALLOCATE": kindUtility,
+	"DECLARE":    kindUtility,
+	"FETCH":      kindUtility,
+	"MOVE":       kindUtility,
+	"CLOSE":      kindUtility,
+	"LOCK":       kindUtility,
+	"LISTEN":     kindUtility,
+	"UNLISTEN":   kindUtility,
+	"NOTIFY":     kindUtility,
+	"DISCARD":    kindUtility,
+	"DO":         kindUtility,
+	"CALL":       kindUtility,
+	"LOAD":       kindUtility,
+}
+
+// classifyQuery returns the type of a statement. Comments, letter case, parentheses around
+// the statement and CTEs are handled: "WITH ... SELECT" is a select unless one of the CTEs
+// modifies data, in which case the statement gets the type of that CTE.
+func classifyQuery(query string) queryKind {
+	return classifyTokens(tokenizeQuery(query))
+}
+
+func classifyTokens(tokens []string) queryKind {
+	idx := 0
+	for idx < len(tokens) && tokens[idx] == "(" {
+		idx++
+	}
+	if idx == len(tokens) {
+		return kindOther
+	}
+
+	switch tokens[idx] {
+	case "WITH":
+		return classifyWith(tokens[idx+1:])
+	case "COPY":
+		return classifyCopy(tokens[idx+1:])
+	}
+
+	if kind, ok := leadingKeywordKinds[tokens[idx]]; ok {
+		return kind
+	}
+	return kindOther
+}
+
+// classifyWith classifies "WITH [RECURSIVE] name [(columns)] AS [[NOT] MATERIALIZED] (body) [, ...] statement".
+func classifyWith(tokens []string) queryKind {
+	var cteKinds []queryKind
+
+	idx := 0
+	if idx < len(tokens) && tokens[idx] == "RECURSIVE" {
+		idx++
+	}
+	for idx < len(tokens) {
+		// CTE name and optional column list
+		idx++
+		if idx < len(tokens) && tokens[idx] == "(" {
+			idx = skipParentheses(tokens, idx)
+		}
+		if idx == len(tokens) || tokens[idx] != "AS" {
+			return kindOther
+		}
+		idx++
+		for idx < len(tokens) && (tokens[idx] == "NOT" || tokens[idx] == "MATERIALIZED") {
+			idx++
+		}
+		if idx == len(tokens) || tokens[idx] != "(" {
+			return kindOther
+		}
+
+		end := skipParentheses(tokens, idx)
+		cteKinds = append(cteKinds, classifyTokens(tokens[idx+1:end]))
+		idx = end
+
+		// optional SEARCH and CYCLE clauses run until the next CTE or the main statement
+		for idx < len(tokens) && tokens[idx] != "," && tokens[idx] != "(" && !isMainStatementKeyword(tokens[idx]) {
+			idx++
+		}
+		if idx < len(tokens) && tokens[idx] == "," {
+			idx++
+			continue
+		}
+		break
+	}
+
+	main := classifyTokens(tokens[idx:])
+	if main != kindSelect {
+		return main
+	}
+	for _, kind := range cteKinds {
+		if kind.isWrite() {
+			return kind
+		}
+	}
+	return main
+}
+
+// classifyCopy distinguishes "COPY ... FROM" that loads data from "COPY ... TO" that exports it.
+func classifyCopy(tokens []string) queryKind {
+	for idx := 0; idx < len(tokens); idx++ {
+		switch tokens[idx] {
+		case "(":
+			idx = skipParentheses(tokens, idx) - 1
+		case "FROM":
+			return kindCopyFrom
+		case "TO":
+			return kindCopyTo
+		}
+	}
+	return kindOther
+}
+
+func isMainStatementKeyword(token string) bool {
+	switch token {
+	case "SELECT", "VALUES", "TABLE", "INSERT", "UPDATE", "MERGE", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// skipParentheses returns the index right after the parenthesis that closes tokens[start].
+func skipParentheses(tokens []string, start int) int {
+	depth := 0
+	for idx := start; idx < len(tokens); idx++ {
+		switch tokens[idx] {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return idx + 1
+			}
+		}
+	}
+	return len(tokens)
+}
+
+// tokenizeQuery splits a statement into upper-cased words and punctuation. Comments are dropped,
+// string literals, dollar-quoted bodies and parameters become a single "?" token.
+func tokenizeQuery(query string) []string {
+	var tokens []string
+	s := []rune(query)
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '-' && i+1 < len(s) && s[i+1] == '-':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(s) && s[i+1] == '*':
+			// block comments may be nested
+			depth := 0
+			for i < len(s) {
+				if s[i] == '/' && i+1 < len(s) && s[i+1] == '*' {
+					depth++
+					i += 2
+				} else if s[i] == '*' && i+1 < len(s) && s[i+1] == '/' {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+		case c == '\'':
+			escapes := len(tokens) > 0 && tokens[len(tokens)-1] == "E" && i > 0 && (s[i-1] == 'E' || s[i-1] == 'e')
+			if escapes {
+				tokens = tokens[:len(tokens)-1]
+			}
+			i = skipQuoted(s, i, '\'', escapes)
+			tokens = append(tokens, "?")
+		case c == '"':
+			end := skipQuoted(s, i, '"', false)
+			tokens = append(tokens, string(s[i:end]))
+			i = end
+		case c == '$':
+			end, ok := skipDollarQuoted(s, i)
+			if !ok {
+				// positional parameter such as $1
+				end = i + 1
+				for end < len(s) && unicode.IsDigit(s[end]) {
+					end++
+				}
+			}
+			tokens = append(tokens, "?")
+			i = end
+		case isWordRune(c):
+			start := i
+			for i < len(s) && isWordRune(s[i]) {
+				i++
+			}
+			tokens = append(tokens, strings.ToUpper(string(s[start:i])))
+		default:
+			tokens = append(tokens, string(c))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// skipQuoted returns the index right after the closing quote, doubled quotes are part of the literal.
+func skipQuoted(s []rune, start int, quote rune, backslashEscapes bool) int {
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case backslashEscapes && s[i] == '\\':
+			i++
+		case s[i] == quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// skipDollarQuoted skips $tag$...$tag$ and reports false when s[start] does not open a dollar quote.
+func skipDollarQuoted(s []rune, start int) (int, bool) {
+	end := start + 1
+	for end < len(s) && (unicode.IsLetter(s[end]) || s[end] == '_' || (end > start+1 && unicode.IsDigit(s[end]))) {
+		end++
+	}
+	if end == len(s) || s[end] != '$' {
+		return 0, false
+	}
+
+	tag := string(s[start : end+1])
+	body := string(s[end+1:])
+	closing := strings.Index(body, tag)
+	if closing == -1 {
+		return len(s), true
+	}
+	return end + 1 + len([]rune(body[:closing])) + len([]rune(tag)), true
+}
@@ -0,0 +1,55 @@
+package collector
+
+import "testing"
+
+func TestClassifyQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  queryKind
+	}{
+		{name: "select", query: "SELECT 1", want: kindSelect},
+		{name: "lower case with leading spaces", query: "  select * from t", want: kindSelect},
+		{name: "values", query: "VALUES (1), (2)", want: kindSelect},
+		{name: "parenthesized union", query: "(SELECT 1) UNION (SELECT 2)", want: kindSelect},
+		{name: "insert", query: "INSERT INTO t VALUES ($1, $2)", want: kindInsert},
+		{name: "update", query: "UPDATE t SET a = $1 WHERE id = $2", want: kindUpdate},
+		{name: "merge", query: "MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN DELETE", want: kindUpdate},
+		{name: "delete", query: "DELETE FROM t WHERE id = $1", want: kindDelete},
+		{name: "truncate", query: "TRUNCATE t", want: kindDelete},
+
+		{name: "line comment", query: "-- DELETE FROM t\nSELECT 1", want: kindSelect},
+		{name: "block comment", query: "/* UPDATE t */ INSERT INTO t VALUES (1)", want: kindInsert},
+		{name: "nested block comment", query: "/* outer /* DELETE */ still comment */ SELECT 1", want: kindSelect},
+		{name: "keyword in literal", query: "SELECT 'DELETE FROM t'", want: kindSelect},
+		{name: "escaped quote in literal", query: `SELECT E'\' ) DELETE', 1`, want: kindSelect},
+		{name: "dollar quoted body", query: "SELECT $body$ ) DELETE FROM t $body$", want: kindSelect},
+
+		{name: "cte select", query: "WITH x AS (SELECT 1) SELECT * FROM x", want: kindSelect},
+		{name: "recursive cte with columns", query: "WITH RECURSIVE r(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM r WHERE n < 10) SELECT * FROM r", want: kindSelect},
+		{name: "cte modifying data", query: "WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d", want: kindDelete},
+		{name: "first modifying cte wins", query: "WITH u AS (UPDATE t SET a = 1 RETURNING id), i AS (INSERT INTO s SELECT id FROM u RETURNING id) SELECT count(*) FROM i", want: kindUpdate},
+		{name: "materialized cte before insert", query: "WITH x AS MATERIALIZED (SELECT 1) INSERT INTO t SELECT * FROM x", want: kindInsert},
+		{name: "not materialized cte", query: "WITH x AS NOT MATERIALIZED (SELECT 1) UPDATE t SET a = (SELECT * FROM x)", want: kindUpdate},
+		{name: "cte with search clause", query: "WITH RECURSIVE r AS (SELECT 1 AS n) SEARCH DEPTH FIRST BY n SET ord SELECT * FROM r", want: kindSelect},
+
+		{name: "copy from", query: "COPY t FROM STDIN", want: kindCopyFrom},
+		{name: "copy to with column list", query: "copy t (a, b) to stdout", want: kindCopyTo},
+		{name: "copy query", query: "COPY (SELECT * FROM t WHERE a = 'FROM') TO STDOUT", want: kindCopyTo},
+
+		{name: "ddl", query: "CREATE INDEX CONCURRENTLY ON t (a)", want: kindDDL},
+		{name: "utility", query: "VACUUM (ANALYZE) t", want: kindUtility},
+		{name: "transaction control", query: "BEGIN ISOLATION LEVEL SERIALIZABLE", want: kindUtility},
+		{name: "empty", query: "", want: kindOther},
+		{name: "comment only", query: "-- nothing here", want: kindOther},
+		{name: "unknown keyword", query: "FOO BAR", want: kindOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyQuery(tt.query); got != tt.want {
+				t.Errorf("classifyQuery(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
-	"strconv"
 
 	"postgresHelper/internal/model"
 )
@@ -20,149 +18,118 @@ type Collector interface {
 	CollectTablesBloat(ctx context.Context) ([]model.TableBloating, model.Scope, error)
 	CollectIndexesBloat(ctx context.Context) ([]model.IndexBloating, model.Scope, error)
 	CollectDatabaseStat(ctx context.Context, databaseName string) (model.DatabaseStat, model.Scope, error)
-	SetKnobs(ctx context.Context, knobs []model.Knob) error
+	CollectWalStat(ctx context.Context) (model.WalStat, model.Scope, error)
+	CollectReplication(ctx context.Context) (model.ReplicationState, model.Scope, error)
+	CollectIOStats(ctx context.Context) ([]model.IOStat, model.Scope, error)
+	CollectSessionWaits(ctx context.Context) ([]model.SessionWait, error)
+	CollectLockWaits(ctx context.Context) ([]model.LockWait, model.Scope, error)
+	CollectTopStatements(ctx context.Context, limit int, orderBy model.StatementsOrder) ([]model.StatementStat, error)
+	SetKnobs(ctx context.Context, knobs []model.Knob) ([]model.KnobApplyResult, error)
 }
 
 type Implementation struct {
-	db *sql.DB
+	db            *sql.DB
+	serverVersion int
+	queries       map[queryID]string
 }
 
-func NewCollector(db *sql.DB) *Implementation {
-	return &Implementation{db: db}
-}
-
-func (i *Implementation) SetKnobs(ctx context.Context, knobs []model.Knob) error {
-
-	for _, knob := range knobs {
-		query := fmt.Sprintf("ALTER SYSTEM SET %s = %v", knob.Name, knob.Value)
-		_, err := i.db.ExecContext(ctx, query)
-		if err != nil {
-			return fmt.Errorf("db.ExecContext: %w", err)
-		}
-	}
+// NewCollector detects the server version and picks queries that match it.
+func NewCollector(ctx context.Context, db *sql.DB) (*Implementation, error) {
+	i := &Implementation{db: db}
 
-	_, err := i.db.ExecContext(ctx, "SELECT pg_reload_conf()")
+	serverVersion, err := i.detectServerVersion(ctx)
 	if err != nil {
-		return fmt.Errorf("db.ExecContext: %w", err)
+		return nil, fmt.Errorf("i.detectServerVersion: %w", err)
 	}
 
-	return nil
-}
-
-func (i *Implementation) CollectKnobs(ctx context.Context) ([]model.Knob, error) {
-	query := `
-SELECT 
-	name, setting, vartype, min_val, max_val
-From pg_settings
-`
-	var knobs []model.Knob
-
-	rows, err := i.db.QueryContext(ctx, query)
+	queries, err := resolveQueries(serverVersion)
 	if err != nil {
-		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var (
-			name, setting, vartype    string
-			minv, maxv                sql.NullString
-			value, minValue, maxValue interface{}
-		)
-		err := rows.Scan(&name, &setting, &vartype, &minv, &maxv)
-		if err != nil {
-			return nil, fmt.Errorf("rows.Scan: %w", err)
-		}
-		switch vartype {
-		case "enum":
-			//on current implementation step, does not collect enum settings.
-			continue
-		case "bool":
-			//on current implementation step, does not collect bool settings
-
-			//if setting == "on" {
-			//	value = true
-			//} else if setting == "off" {
-			//	value = false
-			//} else {
-			//	err = fmt.Errorf("unknown value=%s for name=%s", setting, name)
-			//}
-			continue
-		case "integer", "real":
-			value, err = strconv.ParseFloat(setting, 64)
-			if maxv.Valid {
-				maxValue, err = strconv.ParseFloat(maxv.String, 64)
-			}
-			if minv.Valid {
-				minValue, err = strconv.ParseFloat(minv.String, 64)
-			}
-		case "string":
-			//on current implementation step, does not collect string settings
-
-			//value = setting
-			continue
-		default:
-			err = fmt.Errorf("unknown type=%s for name=%s", vartype, name)
-		}
-		if err != nil {
-			log.Println(err)
-			continue
-		}
-		knobs = append(knobs, model.Knob{
-			Name:   name,
-			Value:  value,
-			MaxVal: maxValue,
-			MinVal: minValue,
-		})
-	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows.Err: %w", err)
+		return nil, fmt.Errorf("resolveQueries: %w", err)
 	}
 
-	return knobs, nil
+	i.serverVersion = serverVersion
+	i.queries = queries
+	return i, nil
 }
 
 func (i *Implementation) CollectQueryTypesDistribution(ctx context.Context) (model.QueryTypesDistribution, model.Scope, error) {
-	rows, err := i.db.QueryContext(ctx, SelectQueryTypesDistribution)
+	rows, err := i.db.QueryContext(ctx, i.queries[queryQueryTypesDistribution])
 	if err != nil {
 		return model.QueryTypesDistribution{}, model.Unspecified, fmt.Errorf("i.db.QueryContext: %w", err)
 	}
 	defer rows.Close()
 
-	var distribution model.QueryTypesDistribution
+	var (
+		distribution                model.QueryTypesDistribution
+		readCalls, writeCalls       int64
+		readExecTime, writeExecTime float64
+	)
 	for rows.Next() {
 		var (
-			queryType string
-			count     int64
+			query         string
+			calls         int64
+			totalExecTime float64
 		)
 
-		err := rows.Scan(&queryType, &count)
+		err := rows.Scan(&query, &calls, &totalExecTime)
 		if err != nil {
 			return model.QueryTypesDistribution{}, model.Unspecified, fmt.Errorf("rows.Scan: %w", err)
 		}
-		switch queryType {
-		case "INSERT":
-			distribution.Insert = count
-		case "UPDATE":
-			distribution.Update = count
-		case "DELETE":
-			distribution.Delete = count
-		case "SELECT":
-			distribution.Select = count
+
+		kind := classifyQuery(query)
+		switch kind {
+		case kindInsert:
+			distribution.Insert += calls
+			distribution.InsertTime += totalExecTime
+		case kindUpdate:
+			distribution.Update += calls
+			distribution.UpdateTime += totalExecTime
+		case kindDelete:
+			distribution.Delete += calls
+			distribution.DeleteTime += totalExecTime
+		case kindSelect:
+			distribution.Select += calls
+			distribution.SelectTime += totalExecTime
+		case kindCopyFrom, kindCopyTo:
+			distribution.Copy += calls
+			distribution.CopyTime += totalExecTime
+		case kindDDL:
+			distribution.DDL += calls
+			distribution.DDLTime += totalExecTime
+		case kindUtility:
+			distribution.Utility += calls
+			distribution.UtilityTime += totalExecTime
 		default:
-			distribution.Other = count
+			distribution.Other += calls
+			distribution.OtherTime += totalExecTime
 		}
 
+		if kind.isRead() {
+			readCalls += calls
+			readExecTime += totalExecTime
+		} else if kind.isWrite() {
+			writeCalls += calls
+			writeExecTime += totalExecTime
+		}
 	}
 	if err := rows.Err(); err != nil {
 		return model.QueryTypesDistribution{}, model.Unspecified, fmt.Errorf("rows.Err: %w", err)
 	}
 
+	if total := readCalls + writeCalls; total > 0 {
+		distribution.ReadCallsRatio = float64(readCalls) / float64(total)
+		distribution.WriteCallsRatio = float64(writeCalls) / float64(total)
+	}
+	if total := readExecTime + writeExecTime; total > 0 {
+		distribution.ReadTimeRatio = readExecTime / total
+		distribution.WriteTimeRatio = writeExecTime / total
+	}
+
 	return distribution, model.General, nil
 }
 
 func (i *Implementation) CollectTablesInfo(ctx context.Context) ([]model.TableStat, model.Scope, error) {
-	rows, err := i.db.QueryContext(ctx, SelectTablesInfo)
+	rows, err := i.db.QueryContext(ctx, i.queries[queryTablesInfo])
 	if err != nil {
 		return nil, model.Unspecified, fmt.Errorf("i.db.QueryContext: %w", err)
 	}
@@ -204,7 +171,7 @@ func (i *Implementation) CollectTablesInfo(ctx context.Context) ([]model.TableSt
 
 func (i *Implementation) CalculateSharedBufferHitRate(ctx context.Context) (model.SharedBufferHitRate, model.Scope, error) {
 	var ration sql.NullFloat64
-	err := i.db.QueryRowContext(ctx, SelectSharedBufferHitRate).Scan(&ration)
+	err := i.db.QueryRowContext(ctx, i.queries[querySharedBufferHitRate]).Scan(&ration)
 
 	if err != nil {
 		return model.SharedBufferHitRate{}, model.Unspecified, fmt.Errorf("row.Scan(): %w", err)
@@ -215,7 +182,7 @@ func (i *Implementation) CalculateSharedBufferHitRate(ctx context.Context) (mode
 
 func (i *Implementation) CollectWalWriteAndFlushStat(ctx context.Context) (model.WalWriteAndFlushStat, model.Scope, error) {
 	var stat model.WalWriteAndFlushStat
-	rows, err := i.db.QueryContext(ctx, SelectWalWriteAndFlushStat)
+	rows, err := i.db.QueryContext(ctx, i.queries[queryWalWriteAndFlushStat])
 	if err != nil {
 		return model.WalWriteAndFlushStat{}, model.Unspecified, fmt.Errorf("i.db.QueryContext: %w", err)
 	}
@@ -245,16 +212,16 @@ func (i *Implementation) CollectWalWriteAndFlushStat(ctx context.Context) (model
 func (i *Implementation) CollectTablesBloat(ctx context.Context) ([]model.TableBloating, model.Scope, error) {
 	var stats []model.TableBloating
 
-	rows, err := i.db.QueryContext(ctx, SelectTablesBloat)
+	rows, err := i.db.QueryContext(ctx, i.queries[queryTablesBloat])
 	if err != nil {
 		return nil, model.Unspecified, fmt.Errorf("db.QueryContext: %w", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		stat := model.TableBloating{}
+		stat := model.TableBloating{Kind: model.BloatKindTable}
 
-		err := rows.Scan(&stat.TableName, &stat.NumOfRows, &stat.BloatInPercent, &stat.BloatInMegabytes, &stat.TableSize)
+		err := rows.Scan(&stat.RelationID, &stat.SchemaName, &stat.TableName, &stat.NumOfRows, &stat.TableBloatInPercent, &stat.TableBloatInMegabytes, &stat.TableSize)
 		if err != nil {
 			return nil, model.Unspecified, fmt.Errorf("rows.Scan: %w", err)
 		}
@@ -272,16 +239,16 @@ func (i *Implementation) CollectTablesBloat(ctx context.Context) ([]model.TableB
 func (i *Implementation) CollectIndexesBloat(ctx context.Context) ([]model.IndexBloating, model.Scope, error) {
 	var stats []model.IndexBloating
 
-	rows, err := i.db.QueryContext(ctx, SelectIndexesBloat)
+	rows, err := i.db.QueryContext(ctx, i.queries[queryIndexesBloat])
 	if err != nil {
 		return nil, model.Unspecified, fmt.Errorf("db.QueryContext: %w", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		stat := model.IndexBloating{}
+		stat := model.IndexBloating{Kind: model.BloatKindIndex}
 
-		err := rows.Scan(&stat.TableName, &stat.IndexName, &stat.BloatInPercent, &stat.BloatInMegabytes, &stat.IndexSize, &stat.TableSize, &stat.NumOfIndexScans)
+		err := rows.Scan(&stat.IndexID, &stat.SchemaName, &stat.TableName, &stat.IndexName, &stat.IndexBloatInPercent, &stat.IndexBloatInMegabytes, &stat.IndexSize, &stat.IndexedTableSize, &stat.NumOfIndexScans)
 		if err != nil {
 			return nil, model.Unspecified, fmt.Errorf("rows.Scan: %w", err)
 		}
@@ -299,7 +266,7 @@ func (i *Implementation) CollectIndexesBloat(ctx context.Context) ([]model.Index
 func (i *Implementation) CollectDatabaseStat(ctx context.Context, databaseName string) (model.DatabaseStat, model.Scope, error) {
 	var stat model.DatabaseStat
 
-	rows, err := i.db.QueryContext(ctx, SelectDatabaseStat, databaseName)
+	rows, err := i.db.QueryContext(ctx, i.queries[queryDatabaseStat], databaseName)
 	if err != nil {
 		return model.DatabaseStat{}, model.Unspecified, fmt.Errorf("db.QueryContext: %w", err)
 	}
@@ -0,0 +1,91 @@
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+// CollectIndexes returns indexes of user tables with their columns, usage and size.
+func (i *Implementation) CollectIndexes(ctx context.Context) ([]model.Index, error) {
+	query, err := i.query(queryIndexes)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := i.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []model.Index
+	for rows.Next() {
+		var (
+			index                                         model.Index
+			columns, operatorClasses, collations, options string
+		)
+		err := rows.Scan(
+			&index.ID,
+			&index.SchemaName,
+			&index.TableName,
+			&index.IndexName,
+			&index.AccessMethod,
+			&columns,
+			&operatorClasses,
+			&collations,
+			&options,
+			&index.Expressions,
+			&index.Predicate,
+			&index.Unique,
+			&index.Primary,
+			&index.Valid,
+			&index.Constraint,
+			&index.Scans,
+			&index.SizeBytes,
+			&index.Definition,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		// int2vector and oidvector are rendered as space separated numbers
+		for _, column := range strings.Fields(columns) {
+			number, err := strconv.Atoi(column)
+			if err != nil {
+				return nil, fmt.Errorf("strconv.Atoi: %w", err)
+			}
+			index.Columns = append(index.Columns, number)
+		}
+		index.OperatorClasses = strings.Fields(operatorClasses)
+		index.Collations = strings.Fields(collations)
+		index.Options = strings.Fields(options)
+
+		indexes = append(indexes, index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return indexes, nil
+}
+
+// CollectStatsReset returns when statistics of the current database were reset, it is not valid if they never were.
+func (i *Implementation) CollectStatsReset(ctx context.Context) (sql.Null[time.Time], error) {
+	query, err := i.query(queryStatsReset)
+	if err != nil {
+		return sql.Null[time.Time]{}, err
+	}
+
+	var statsReset sql.Null[time.Time]
+	err = i.db.QueryRowContext(ctx, query).Scan(&statsReset)
+	if err != nil {
+		return sql.Null[time.Time]{}, fmt.Errorf("row.Scan: %w", err)
+	}
+	return statsReset, nil
+}
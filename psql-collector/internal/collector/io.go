@@ -0,0 +1,76 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"postgresHelper/internal/model"
+)
+
+// CollectWalStat returns model.ErrNotSupported before PostgreSQL 14.
+func (i *Implementation) CollectWalStat(ctx context.Context) (model.WalStat, model.Scope, error) {
+	query, err := i.query(queryWalStat)
+	if err != nil {
+		return model.WalStat{}, model.Unspecified, err
+	}
+
+	var stat model.WalStat
+	err = i.db.QueryRowContext(ctx, query).Scan(
+		&stat.WalRecords,
+		&stat.WalFpi,
+		&stat.WalBytes,
+		&stat.WalBuffersFull,
+		&stat.WalWrite,
+		&stat.WalSync,
+		&stat.WalWriteTime,
+		&stat.WalSyncTime,
+	)
+	if err != nil {
+		return model.WalStat{}, model.Unspecified, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	return stat, model.General, nil
+}
+
+// CollectIOStats returns model.ErrNotSupported before PostgreSQL 16.
+func (i *Implementation) CollectIOStats(ctx context.Context) ([]model.IOStat, model.Scope, error) {
+	query, err := i.query(queryIOStats)
+	if err != nil {
+		return nil, model.Unspecified, err
+	}
+
+	rows, err := i.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, model.Unspecified, fmt.Errorf("db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []model.IOStat
+	for rows.Next() {
+		var stat model.IOStat
+		err := rows.Scan(
+			&stat.BackendType,
+			&stat.Object,
+			&stat.Context,
+			&stat.Reads,
+			&stat.ReadTime,
+			&stat.Writes,
+			&stat.WriteTime,
+			&stat.Writebacks,
+			&stat.Extends,
+			&stat.Fsyncs,
+			&stat.FsyncTime,
+			&stat.Hits,
+			&stat.Evictions,
+		)
+		if err != nil {
+			return nil, model.Unspecified, fmt.Errorf("rows.Scan: %w", err)
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, model.Unspecified, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return stats, model.General, nil
+}
@@ -0,0 +1,558 @@
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"postgresHelper/internal/model"
+)
+
+const (
+	// reloadTimeout bounds the wait for a session to re-read configuration files after pg_reload_conf
+	reloadTimeout      = 5 * time.Second
+	reloadPollInterval = 50 * time.Millisecond
+)
+
+// queryer is *sql.DB or *sql.Conn. Settings read after reload come from the session that observed it.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// autoConfSetting is a postgresql.auto.conf entry from pg_file_settings.
+type autoConfSetting struct {
+	setting string
+	applied bool
+	error   sql.NullString
+}
+
+func (i *Implementation) CollectKnobs(ctx context.Context) ([]model.Knob, error) {
+	return i.collectKnobs(ctx, i.db)
+}
+
+func (i *Implementation) collectKnobs(ctx context.Context, q queryer) ([]model.Knob, error) {
+	var knobs []model.Knob
+
+	rows, err := q.QueryContext(ctx, i.queries[querySettings])
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		knob, err := scanKnob(rows)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		knobs = append(knobs, knob)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return knobs, nil
+}
+
+// SetKnobs validates knobs against pg_settings and applies them with ALTER SYSTEM.
+// Either all knobs are written or, on failure, the already written ones are reverted
+// to the values they had in postgresql.auto.conf. After reload it reports per knob
+// whether the value took effect, waits for restart or was rejected by the server.
+func (i *Implementation) SetKnobs(ctx context.Context, knobs []model.Knob) ([]model.KnobApplyResult, error) {
+	names := make([]string, 0, len(knobs))
+	for _, knob := range knobs {
+		if slices.Contains(names, knob.Name) {
+			return nil, fmt.Errorf("%w: knob %s is specified more than once", model.ErrInvalidKnob, knob.Name)
+		}
+		names = append(names, knob.Name)
+	}
+
+	settings, err := i.loadSettings(ctx, i.db, names)
+	if err != nil {
+		return nil, fmt.Errorf("i.loadSettings: %w", err)
+	}
+
+	values := make([]interface{}, 0, len(knobs))
+	statements := make([]string, 0, len(knobs))
+	for _, knob := range knobs {
+		setting, ok := settings[knob.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown knob %s", model.ErrInvalidKnob, knob.Name)
+		}
+
+		value, err := validateKnobValue(setting, knob.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: knob %s: %v", model.ErrInvalidKnob, knob.Name, err)
+		}
+
+		literal, err := formatKnobValue(setting, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: knob %s: %v", model.ErrInvalidKnob, knob.Name, err)
+		}
+		values = append(values, value)
+		statements = append(statements, fmt.Sprintf("ALTER SYSTEM SET %s = %s", quoteKnobName(knob.Name), literal))
+	}
+
+	previous, err := i.loadAutoConfSettings(ctx, i.db, names)
+	if err != nil {
+		return nil, fmt.Errorf("i.loadAutoConfSettings: %w", err)
+	}
+
+	return i.writeAutoConf(ctx, names, statements, values, previous)
+}
+
+// writeAutoConf runs ALTER SYSTEM statements, one per knob of names. On failure the already
+// written knobs are reverted to previous. After reload it reports the outcome of every knob.
+func (i *Implementation) writeAutoConf(ctx context.Context, names, statements []string, values []interface{}, previous map[string]autoConfSetting) ([]model.KnobApplyResult, error) {
+	for idx, statement := range statements {
+		_, err := i.db.ExecContext(ctx, statement)
+		if err == nil {
+			continue
+		}
+
+		applyErr := fmt.Errorf("apply knob %s: %w", names[idx], err)
+		if rollbackErr := i.rollbackKnobs(ctx, names[:idx], previous); rollbackErr != nil {
+			return nil, errors.Join(applyErr, fmt.Errorf("i.rollbackKnobs: %w", rollbackErr))
+		}
+		return nil, applyErr
+	}
+
+	conn, err := i.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	err = reloadConf(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("reloadConf: %w", err)
+	}
+
+	results, err := i.checkKnobsApplied(ctx, conn, names, values)
+	if err != nil {
+		return nil, fmt.Errorf("i.checkKnobsApplied: %w", err)
+	}
+
+	return results, nil
+}
+
+// reloadConf calls pg_reload_conf and waits until the session of conn has re-read configuration files,
+// backends process the SIGHUP sent by the postmaster asynchronously.
+func reloadConf(ctx context.Context, conn *sql.Conn) error {
+	var loadedAt time.Time
+	err := conn.QueryRowContext(ctx, "SELECT pg_conf_load_time()").Scan(&loadedAt)
+	if err != nil {
+		return fmt.Errorf("conn.QueryRowContext: %w", err)
+	}
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_reload_conf()")
+	if err != nil {
+		return fmt.Errorf("conn.ExecContext: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, reloadTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(reloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var reloadedAt time.Time
+		err := conn.QueryRowContext(ctx, "SELECT pg_conf_load_time()").Scan(&reloadedAt)
+		if err != nil {
+			return fmt.Errorf("conn.QueryRowContext: %w", err)
+		}
+		if reloadedAt.After(loadedAt) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("configuration was not reloaded within %s: %w", reloadTimeout, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkKnobsApplied re-reads pg_settings and pg_file_settings over the reloaded session
+// and reports the outcome of every written knob. Knobs missing from postgresql.auto.conf were reset.
+func (i *Implementation) checkKnobsApplied(ctx context.Context, conn *sql.Conn, names []string, values []interface{}) ([]model.KnobApplyResult, error) {
+	settings, err := i.loadSettings(ctx, conn, names)
+	if err != nil {
+		return nil, fmt.Errorf("i.loadSettings: %w", err)
+	}
+
+	fileSettings, err := i.loadAutoConfSettings(ctx, conn, names)
+	if err != nil {
+		return nil, fmt.Errorf("i.loadAutoConfSettings: %w", err)
+	}
+
+	results := make([]model.KnobApplyResult, 0, len(names))
+	for idx, name := range names {
+		setting := settings[name]
+		fileSetting, written := fileSettings[name]
+
+		result := model.KnobApplyResult{
+			Name:           name,
+			RequestedValue: values[idx],
+			EffectiveValue: setting.Value,
+		}
+		switch {
+		case setting.PendingRestart || (written && setting.Context == model.ContextPostmaster && !fileSetting.applied):
+			result.Status = model.KnobPendingRestart
+		case !written:
+			// the knob takes its value from postgresql.conf or the default again
+			result.Status = model.KnobApplied
+		case fileSetting.error.Valid:
+			result.Status = model.KnobRejected
+			result.Error = fileSetting.error.String
+		case !fileSetting.applied:
+			result.Status = model.KnobRejected
+			result.Error = "value is overridden by a source with higher priority"
+		default:
+			result.Status = model.KnobApplied
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// rollbackKnobs restores postgresql.auto.conf entries of the given knobs.
+// Knobs that were not present in postgresql.auto.conf are reset.
+func (i *Implementation) rollbackKnobs(ctx context.Context, names []string, previous map[string]autoConfSetting) error {
+	var errs []error
+	for idx := len(names) - 1; idx >= 0; idx-- {
+		name := names[idx]
+
+		statement := fmt.Sprintf("ALTER SYSTEM RESET %s", quoteKnobName(name))
+		if prev, ok := previous[name]; ok {
+			statement = fmt.Sprintf("ALTER SYSTEM SET %s = %s", quoteKnobName(name), pq.QuoteLiteral(prev.setting))
+		}
+
+		_, err := i.db.ExecContext(ctx, statement)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rollback knob %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (i *Implementation) loadSettings(ctx context.Context, q queryer, names []string) (map[string]model.Knob, error) {
+	rows, err := q.QueryContext(ctx, i.queries[querySettingsByNames], pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	settings := make(map[string]model.Knob, len(names))
+	for rows.Next() {
+		knob, err := scanKnob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanKnob: %w", err)
+		}
+		settings[knob.Name] = knob
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (i *Implementation) loadAutoConfSettings(ctx context.Context, q queryer, names []string) (map[string]autoConfSetting, error) {
+	rows, err := q.QueryContext(ctx, i.queries[queryAutoConfSettings], pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	settings := make(map[string]autoConfSetting)
+	for rows.Next() {
+		var (
+			name    string
+			setting autoConfSetting
+		)
+		if err := rows.Scan(&name, &setting.setting, &setting.applied, &setting.error); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		settings[name] = setting
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return settings, nil
+}
+
+func scanKnob(rows *sql.Rows) (model.Knob, error) {
+	var (
+		name, setting, vartype    string
+		knobContext, source       string
+		pendingRestart            bool
+		unit, minv, maxv          sql.NullString
+		enumvals                  pq.StringArray
+		value, minValue, maxValue interface{}
+		canonicalUnit             string
+	)
+	err := rows.Scan(&name, &setting, &vartype, &unit, &minv, &maxv, &enumvals, &knobContext, &pendingRestart, &source)
+	if err != nil {
+		return model.Knob{}, fmt.Errorf("rows.Scan: %w", err)
+	}
+	switch vartype {
+	case model.VarTypeEnum, model.VarTypeString:
+		value = setting
+	case model.VarTypeBool:
+		switch setting {
+		case "on":
+			value = true
+		case "off":
+			value = false
+		default:
+			err = fmt.Errorf("unknown value=%s for name=%s", setting, name)
+		}
+	case model.VarTypeInteger, model.VarTypeReal:
+		value, maxValue, minValue, canonicalUnit, err = parseNumericKnob(setting, minv, maxv, unit.String)
+	default:
+		err = fmt.Errorf("unknown type=%s for name=%s", vartype, name)
+	}
+	if err != nil {
+		return model.Knob{}, err
+	}
+
+	return model.Knob{
+		Name:     name,
+		VarType:  vartype,
+		Unit:     canonicalUnit,
+		Value:    value,
+		MaxVal:   maxValue,
+		MinVal:   minValue,
+		EnumVals: enumvals,
+
+		Context:        knobContext,
+		PendingRestart: pendingRestart,
+		Source:         source,
+	}, nil
+}
+
+// parseNumericKnob parses setting and its bounds and converts them from pg_settings.unit to canonical units.
+func parseNumericKnob(setting string, minv, maxv sql.NullString, unit string) (value, maxValue, minValue interface{}, canonicalUnit string, err error) {
+	multiplier, canonicalUnit, err := model.ParseUnit(unit)
+	if err != nil {
+		return nil, nil, nil, "", fmt.Errorf("model.ParseUnit: %w", err)
+	}
+
+	v, err := strconv.ParseFloat(setting, 64)
+	if err != nil {
+		return nil, nil, nil, "", fmt.Errorf("strconv.ParseFloat: %w", err)
+	}
+	value = v * multiplier
+
+	if maxv.Valid {
+		v, err := strconv.ParseFloat(maxv.String, 64)
+		if err != nil {
+			return nil, nil, nil, "", fmt.Errorf("strconv.ParseFloat: %w", err)
+		}
+		maxValue = v * multiplier
+	}
+	if minv.Valid {
+		v, err := strconv.ParseFloat(minv.String, 64)
+		if err != nil {
+			return nil, nil, nil, "", fmt.Errorf("strconv.ParseFloat: %w", err)
+		}
+		minValue = v * multiplier
+	}
+
+	return value, maxValue, minValue, canonicalUnit, nil
+}
+
+// validateKnobValue checks value against the knob type and pg_settings bounds and
+// returns it as float64 in canonical units, bool or string.
+// Strings for numeric knobs may carry human-readable units such as "256MB" or "5min",
+// strings without unit are in canonical units like float values, see model.ToCanonicalValue.
+func validateKnobValue(setting model.Knob, value interface{}) (interface{}, error) {
+	if !setting.IsTunable() {
+		return nil, fmt.Errorf("knob can not be changed")
+	}
+
+	switch setting.VarType {
+	case model.VarTypeInteger, model.VarTypeReal:
+		number, err := model.ToCanonicalValue(value, setting.Unit)
+		if err != nil {
+			return nil, err
+		}
+		if minVal, ok := setting.MinVal.(float64); ok && number < minVal {
+			return nil, fmt.Errorf("value %v is less than minimum %v", number, minVal)
+		}
+		if maxVal, ok := setting.MaxVal.(float64); ok && number > maxVal {
+			return nil, fmt.Errorf("value %v is greater than maximum %v", number, maxVal)
+		}
+		return number, nil
+	case model.VarTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			switch strings.ToLower(v) {
+			case "on", "true", "yes", "1":
+				return true, nil
+			case "off", "false", "no", "0":
+				return false, nil
+			}
+		}
+		return nil, fmt.Errorf("value %v is not a boolean", value)
+	case model.VarTypeEnum:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value %v is not a string", value)
+		}
+		idx := slices.IndexFunc(setting.EnumVals, func(enumVal string) bool {
+			return strings.EqualFold(enumVal, v)
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf("value %q is not one of %v", v, setting.EnumVals)
+		}
+		return setting.EnumVals[idx], nil
+	case model.VarTypeString:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value %v is not a string", value)
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported knob type %s", setting.VarType)
+	}
+}
+
+// formatKnobValue renders validated knob value as a literal suitable for ALTER SYSTEM SET.
+func formatKnobValue(setting model.Knob, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case float64:
+		literal := model.FormatCanonicalValue(v, setting.Unit)
+		if setting.Unit == "" {
+			return literal, nil
+		}
+		return pq.QuoteLiteral(literal), nil
+	case bool:
+		if v {
+			return "on", nil
+		}
+		return "off", nil
+	case string:
+		return pq.QuoteLiteral(v), nil
+	default:
+		return "", fmt.Errorf("unsupported knob value type %T", value)
+	}
+}
+
+// quoteKnobName quotes every part of a possibly qualified knob name like pg_stat_statements.track.
+func quoteKnobName(name string) string {
+	parts := strings.Split(name, ".")
+	for idx, part := range parts {
+		parts[idx] = pq.QuoteIdentifier(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// CollectAutoConf returns postgresql.auto.conf entries, the knobs overridden with ALTER SYSTEM.
+func (i *Implementation) CollectAutoConf(ctx context.Context) ([]model.AutoConfSetting, error) {
+	entries, err := i.loadAutoConf(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("i.loadAutoConf: %w", err)
+	}
+
+	settings := make([]model.AutoConfSetting, 0, len(entries))
+	for name, entry := range entries {
+		settings = append(settings, model.AutoConfSetting{Name: name, Setting: entry.setting})
+	}
+	slices.SortFunc(settings, func(a, b model.AutoConfSetting) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return settings, nil
+}
+
+// RestoreAutoConf brings postgresql.auto.conf back to settings: entries missing from settings are reset
+// and entries with another value are written as saved, knobs that are not overridden stay untouched.
+// Like SetKnobs it reverts the written entries on failure and reports the outcome of every changed knob,
+// reset knobs have no requested value.
+func (i *Implementation) RestoreAutoConf(ctx context.Context, settings []model.AutoConfSetting) ([]model.KnobApplyResult, error) {
+	current, err := i.loadAutoConf(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("i.loadAutoConf: %w", err)
+	}
+
+	saved := make(map[string]string, len(settings))
+	for _, setting := range settings {
+		saved[setting.Name] = setting.Setting
+	}
+
+	var (
+		names      []string
+		statements []string
+		values     []interface{}
+	)
+	for _, name := range sortedKeys(current) {
+		if _, ok := saved[name]; !ok {
+			names = append(names, name)
+			statements = append(statements, fmt.Sprintf("ALTER SYSTEM RESET %s", quoteKnobName(name)))
+			values = append(values, nil)
+		}
+	}
+	for _, name := range sortedKeys(saved) {
+		if entry, ok := current[name]; ok && entry.setting == saved[name] {
+			continue
+		}
+		names = append(names, name)
+		statements = append(statements, fmt.Sprintf("ALTER SYSTEM SET %s = %s", quoteKnobName(name), pq.QuoteLiteral(saved[name])))
+		values = append(values, saved[name])
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	return i.writeAutoConf(ctx, names, statements, values, current)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// loadAutoConf returns all postgresql.auto.conf entries by knob name.
+func (i *Implementation) loadAutoConf(ctx context.Context) (map[string]autoConfSetting, error) {
+	rows, err := i.db.QueryContext(ctx, i.queries[queryAutoConf])
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]autoConfSetting)
+	for rows.Next() {
+		var (
+			name  string
+			entry autoConfSetting
+		)
+		if err := rows.Scan(&name, &entry.setting); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		// later lines override earlier ones
+		entries[name] = entry
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,92 @@
+package collector
+
+import (
+	"testing"
+
+	"postgresHelper/internal/model"
+)
+
+func TestFormatKnobValue(t *testing.T) {
+	bytesKnob := model.Knob{Name: "shared_buffers", VarType: model.VarTypeInteger, Unit: model.UnitBytes}
+	timeKnob := model.Knob{Name: "checkpoint_timeout", VarType: model.VarTypeInteger, Unit: model.UnitMilliseconds}
+	unitlessKnob := model.Knob{Name: "max_connections", VarType: model.VarTypeInteger}
+
+	tests := []struct {
+		name    string
+		setting model.Knob
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "bytes", setting: bytesKnob, value: 268435456., want: "'268435456B'"},
+		{name: "bytes above float32 precision", setting: bytesKnob, value: 17179869185., want: "'17179869185B'"},
+		{name: "milliseconds", setting: timeKnob, value: 300000., want: "'300000ms'"},
+		{name: "fractional milliseconds", setting: timeKnob, value: 0.5, want: "'0.5ms'"},
+		{name: "unitless", setting: unitlessKnob, value: 100., want: "100"},
+		{name: "real", setting: model.Knob{Name: "random_page_cost", VarType: model.VarTypeReal}, value: 1.1, want: "1.1"},
+		{name: "bool on", setting: model.Knob{VarType: model.VarTypeBool}, value: true, want: "on"},
+		{name: "bool off", setting: model.Knob{VarType: model.VarTypeBool}, value: false, want: "off"},
+		{name: "enum", setting: model.Knob{VarType: model.VarTypeEnum}, value: "replica", want: "'replica'"},
+		{name: "string with quote", setting: model.Knob{VarType: model.VarTypeString}, value: "it's", want: "'it''s'"},
+		{name: "unsupported type", setting: unitlessKnob, value: 100, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatKnobValue(tt.setting, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("formatKnobValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("formatKnobValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateKnobValue(t *testing.T) {
+	workMem := model.Knob{
+		Name:    "work_mem",
+		VarType: model.VarTypeInteger,
+		Unit:    model.UnitBytes,
+		MinVal:  65536.,
+		MaxVal:  2199023254528.,
+	}
+	walLevel := model.Knob{
+		Name:     "wal_level",
+		VarType:  model.VarTypeEnum,
+		EnumVals: []string{"minimal", "replica", "logical"},
+	}
+
+	tests := []struct {
+		name    string
+		setting model.Knob
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "canonical float", setting: workMem, value: 4194304., want: 4194304.},
+		{name: "human unit", setting: workMem, value: "64MB", want: 67108864.},
+		{name: "string without unit is canonical", setting: workMem, value: "1048576", want: 1048576.},
+		{name: "unit of another kind", setting: workMem, value: "5min", wantErr: true},
+		{name: "below minimum", setting: workMem, value: "32kB", wantErr: true},
+		{name: "unknown unit", setting: workMem, value: "4XB", wantErr: true},
+		{name: "bool as string", setting: model.Knob{VarType: model.VarTypeBool}, value: "off", want: false},
+		{name: "enum is case insensitive", setting: walLevel, value: "Logical", want: "logical"},
+		{name: "unknown enum value", setting: walLevel, value: "archive", wantErr: true},
+		{name: "internal knob", setting: model.Knob{VarType: model.VarTypeInteger, Context: model.ContextInternal}, value: 1., wantErr: true},
+		{name: "file location knob", setting: model.Knob{Name: "data_directory", VarType: model.VarTypeString, Context: model.ContextPostmaster}, value: "/tmp", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateKnobValue(tt.setting, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateKnobValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("validateKnobValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"postgresHelper/internal/model"
+)
+
+// CollectRelation returns the kind and total size of a table or an index.
+func (i *Implementation) CollectRelation(ctx context.Context, schemaName, relationName string) (model.Relation, error) {
+	query, err := i.query(queryRelation)
+	if err != nil {
+		return model.Relation{}, err
+	}
+
+	relation := model.Relation{SchemaName: schemaName, RelationName: relationName}
+	err = i.db.QueryRowContext(ctx, query, model.QualifiedName(schemaName, relationName)).
+		Scan(&relation.Kind, &relation.SizeBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Relation{}, fmt.Errorf("%w: unknown relation %s", model.ErrInvalidMaintenance, model.QualifiedName(schemaName, relationName))
+	}
+	if err != nil {
+		return model.Relation{}, fmt.Errorf("row.Scan: %w", err)
+	}
+	return relation, nil
+}
+
+// PrepareMaintenance checks that the action can run on the relation and returns the relation.
+func (i *Implementation) PrepareMaintenance(ctx context.Context, action model.MaintenanceAction, schemaName, relationName string) (model.Relation, error) {
+	relation, err := i.CollectRelation(ctx, schemaName, relationName)
+	if err != nil {
+		return model.Relation{}, fmt.Errorf("i.CollectRelation: %w", err)
+	}
+
+	if _, err := maintenanceStatement(action, relation); err != nil {
+		return model.Relation{}, err
+	}
+	return relation, nil
+}
+
+// RunMaintenance runs the action on a dedicated connection and calls started with the pid of its backend
+// before the statement begins. Cancelling ctx cancels the statement.
+func (i *Implementation) RunMaintenance(ctx context.Context, action model.MaintenanceAction, relation model.Relation, started func(pid int64)) error {
+	statement, err := maintenanceStatement(action, relation)
+	if err != nil {
+		return err
+	}
+
+	conn, err := i.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("db.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	var pid int64
+	err = conn.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid)
+	if err != nil {
+		return fmt.Errorf("row.Scan: %w", err)
+	}
+	started(pid)
+
+	_, err = conn.ExecContext(ctx, statement)
+	if err != nil {
+		return fmt.Errorf("conn.ExecContext: %w", err)
+	}
+	return nil
+}
+
+// CollectMaintenanceProgress returns the progress of a maintenance statement run by the backend,
+// it is empty between phases that report progress.
+func (i *Implementation) CollectMaintenanceProgress(ctx context.Context, pid int64) (model.MaintenanceProgress, error) {
+	query, err := i.query(queryMaintenanceProgress)
+	if err != nil {
+		return model.MaintenanceProgress{}, err
+	}
+
+	var progress model.MaintenanceProgress
+	err = i.db.QueryRowContext(ctx, query, pid).
+		Scan(&progress.Phase, &progress.BlocksTotal, &progress.BlocksDone)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.MaintenanceProgress{}, nil
+	}
+	if err != nil {
+		return model.MaintenanceProgress{}, fmt.Errorf("row.Scan: %w", err)
+	}
+	return progress, nil
+}
+
+func maintenanceStatement(action model.MaintenanceAction, relation model.Relation) (string, error) {
+	name := model.QualifiedName(relation.SchemaName, relation.RelationName)
+
+	switch action {
+	case model.MaintenanceVacuum, model.MaintenanceAnalyze:
+		switch relation.Kind {
+		case model.RelKindTable, model.RelKindMaterializedView, model.RelKindToastTable, model.RelKindPartitionedTable:
+		default:
+			return "", fmt.Errorf("%w: %s can only run on tables, %s is not a table", model.ErrInvalidMaintenance, action, name)
+		}
+		if action == model.MaintenanceVacuum {
+			return "VACUUM (ANALYZE) " + name, nil
+		}
+		return "ANALYZE " + name, nil
+	case model.MaintenanceReindex:
+		switch relation.Kind {
+		case model.RelKindTable, model.RelKindMaterializedView, model.RelKindToastTable:
+			return "REINDEX TABLE CONCURRENTLY " + name, nil
+		case model.RelKindIndex:
+			return "REINDEX INDEX CONCURRENTLY " + name, nil
+		default:
+			return "", fmt.Errorf("%w: %s can not run on %s", model.ErrInvalidMaintenance, action, name)
+		}
+	default:
+		return "", fmt.Errorf("%w: unknown action", model.ErrInvalidMaintenance)
+	}
+}
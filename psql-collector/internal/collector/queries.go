@@ -31,39 +31,89 @@ SELECT
 FROM pg_statio_user_tables;
 `
 
-	SelectQueryTypesDistribution = `
+	// pgStatStatementsPG12 gives pg_stat_statements columns of PostgreSQL 12 the names
+	// they have since PostgreSQL 13, WAL usage is not tracked before 13
+	pgStatStatementsPG12 = `(
 SELECT
-  query_type,
-  count(*) AS total
-FROM (
-  SELECT
-    CASE
-      WHEN query LIKE 'INSERT%' THEN 'INSERT'
-      WHEN query LIKE 'UPDATE%' THEN 'UPDATE'
-      WHEN query LIKE 'DELETE%' THEN 'DELETE'
-      WHEN query LIKE 'SELECT%' THEN 'SELECT'
-      ELSE 'OTHER'
-    END AS query_type
-  FROM pg_stat_statements
-) AS categorized_queries
-GROUP BY query_type
-ORDER BY total DESC;
+  *,
+  total_time AS total_exec_time,
+  mean_time AS mean_exec_time,
+  stddev_time AS stddev_exec_time,
+  0::numeric AS wal_bytes
+FROM pg_stat_statements
+) AS pg_stat_statements`
+
+	// SelectQueryTypesDistribution returns statements of the current database,
+	// they are classified by type in Go
+	SelectQueryTypesDistribution     = queryTypesDistributionSelect + `pg_stat_statements` + queryTypesDistributionWhere
+	SelectQueryTypesDistributionPG12 = queryTypesDistributionSelect + pgStatStatementsPG12 + queryTypesDistributionWhere
+
+	queryTypesDistributionSelect = `
+SELECT
+  query,
+  sum(calls) AS calls,
+  sum(total_exec_time) AS total_exec_time
+FROM `
+	queryTypesDistributionWhere = `
+WHERE dbid = (SELECT oid FROM pg_database WHERE datname = current_database())
+GROUP BY query;
 `
 
+	// SelectLockingInformation returns a row per blocked backend and backend blocking it
 	SelectLockingInformation = `
 SELECT
-  bl.pid AS blocked_pid,
-  a.usename AS blocked_user,
-  ka.query AS blocking_query,
-  now() - ka.query_start AS query_time,
-  kl.pid AS blocking_pid,
-  ka.usename AS blocking_user,
-  a.query AS blocked_query
-FROM pg_catalog.pg_locks bl
-JOIN pg_catalog.pg_stat_activity a ON a.pid = bl.pid
-JOIN pg_catalog.pg_locks kl ON kl.transactionid = bl.transactionid AND kl.pid != bl.pid
-JOIN pg_catalog.pg_stat_activity ka ON ka.pid = kl.pid
-WHERE NOT bl.granted;
+  a.pid AS blocked_pid,
+  coalesce(a.usename, '') AS blocked_user,
+  coalesce(a.query, '') AS blocked_query,
+  coalesce(a.state, '') AS blocked_state,
+  l.mode AS lock_mode,
+  l.locktype AS lock_type,
+  coalesce(l.relation::regclass::text, '') AS relation,
+  extract(epoch FROM now() - coalesce(l.waitstart, a.query_start)) * 1000 AS wait_time,
+  ka.pid AS blocking_pid,
+  coalesce(ka.usename, '') AS blocking_user,
+  coalesce(ka.query, '') AS blocking_query,
+  coalesce(ka.state, '') AS blocking_state
+FROM pg_catalog.pg_locks l
+JOIN pg_catalog.pg_stat_activity a ON a.pid = l.pid
+CROSS JOIN LATERAL unnest(pg_catalog.pg_blocking_pids(l.pid)) AS blocking(pid)
+JOIN pg_catalog.pg_stat_activity ka ON ka.pid = blocking.pid
+WHERE NOT l.granted;
+`
+
+	// SelectLockingInformationPG12 measures waits from the start of the query, pg_locks.waitstart
+	// is not available before PostgreSQL 14
+	SelectLockingInformationPG12 = `
+SELECT
+  a.pid AS blocked_pid,
+  coalesce(a.usename, '') AS blocked_user,
+  coalesce(a.query, '') AS blocked_query,
+  coalesce(a.state, '') AS blocked_state,
+  l.mode AS lock_mode,
+  l.locktype AS lock_type,
+  coalesce(l.relation::regclass::text, '') AS relation,
+  extract(epoch FROM now() - a.query_start) * 1000 AS wait_time,
+  ka.pid AS blocking_pid,
+  coalesce(ka.usename, '') AS blocking_user,
+  coalesce(ka.query, '') AS blocking_query,
+  coalesce(ka.state, '') AS blocking_state
+FROM pg_catalog.pg_locks l
+JOIN pg_catalog.pg_stat_activity a ON a.pid = l.pid
+CROSS JOIN LATERAL unnest(pg_catalog.pg_blocking_pids(l.pid)) AS blocking(pid)
+JOIN pg_catalog.pg_stat_activity ka ON ka.pid = blocking.pid
+WHERE NOT l.granted;
+`
+
+	SelectSessionWaits = `
+SELECT
+  coalesce(wait_event_type, 'CPU') AS wait_event_type,
+  coalesce(wait_event, 'CPU') AS wait_event,
+  state
+FROM pg_catalog.pg_stat_activity
+WHERE backend_type = 'client backend'
+  AND state IS NOT NULL
+  AND state <> 'idle'
+  AND pid <> pg_backend_pid();
 `
 
 	SelectWalWriteAndFlushStat = `
@@ -81,6 +131,154 @@ SELECT
 FROM pg_stat_bgwriter;
 `
 
+	// SelectWalWriteAndFlushStatPG17 reads checkpoint counters from pg_stat_checkpointer
+	// and writes of backends from pg_stat_io, they were removed from pg_stat_bgwriter in PostgreSQL 17
+	SelectWalWriteAndFlushStatPG17 = `
+SELECT
+    c.num_timed,
+    c.num_requested,
+    c.write_time,
+    c.sync_time,
+    c.buffers_written,
+    b.buffers_clean,
+    b.maxwritten_clean,
+    io.writes,
+    io.fsyncs,
+    b.buffers_alloc
+FROM pg_stat_checkpointer c
+CROSS JOIN pg_stat_bgwriter b
+CROSS JOIN (
+    SELECT
+        coalesce(sum(writes), 0) AS writes,
+        coalesce(sum(fsyncs), 0) AS fsyncs
+    FROM pg_stat_io
+    WHERE object = 'relation'
+      AND backend_type NOT IN ('checkpointer', 'background writer')
+) io;
+`
+
+	// SelectWalStat is available since PostgreSQL 14
+	SelectWalStat = `
+SELECT
+    wal_records,
+    wal_fpi,
+    wal_bytes,
+    wal_buffers_full,
+    wal_write,
+    wal_sync,
+    wal_write_time,
+    wal_sync_time
+FROM pg_stat_wal;
+`
+
+	// SelectWalStatPG18 reads WAL writes and syncs from pg_stat_io,
+	// they were removed from pg_stat_wal in PostgreSQL 18
+	SelectWalStatPG18 = `
+SELECT
+    w.wal_records,
+    w.wal_fpi,
+    w.wal_bytes,
+    w.wal_buffers_full,
+    io.writes,
+    io.fsyncs,
+    io.write_time,
+    io.fsync_time
+FROM pg_stat_wal w
+CROSS JOIN (
+    SELECT
+        coalesce(sum(writes), 0) AS writes,
+        coalesce(sum(fsyncs), 0) AS fsyncs,
+        coalesce(sum(write_time), 0) AS write_time,
+        coalesce(sum(fsync_time), 0) AS fsync_time
+    FROM pg_stat_io
+    WHERE object = 'wal'
+) io;
+`
+
+	// SelectIOStats is available since PostgreSQL 16, operations a backend type
+	// does not perform in a context are NULL and reported as zero
+	SelectIOStats = `
+SELECT
+    backend_type,
+    object,
+    context,
+    coalesce(reads, 0),
+    coalesce(read_time, 0),
+    coalesce(writes, 0),
+    coalesce(write_time, 0),
+    coalesce(writebacks, 0),
+    coalesce(extends, 0),
+    coalesce(fsyncs, 0),
+    coalesce(fsync_time, 0),
+    coalesce(hits, 0),
+    coalesce(evictions, 0)
+FROM pg_stat_io;
+`
+
+	// SelectIOStatsPG18 skips WAL rows added to pg_stat_io in PostgreSQL 18,
+	// they are reported by SelectWalStatPG18
+	SelectIOStatsPG18 = `
+SELECT
+    backend_type,
+    object,
+    context,
+    coalesce(reads, 0),
+    coalesce(read_time, 0),
+    coalesce(writes, 0),
+    coalesce(write_time, 0),
+    coalesce(writebacks, 0),
+    coalesce(extends, 0),
+    coalesce(fsyncs, 0),
+    coalesce(fsync_time, 0),
+    coalesce(hits, 0),
+    coalesce(evictions, 0)
+FROM pg_stat_io
+WHERE object <> 'wal';
+`
+
+	SelectInRecovery = `SELECT pg_is_in_recovery();`
+
+	// SelectReplicas measures replay lag in bytes from the current WAL position,
+	// standbys with cascading replicas use the last received position instead
+	SelectReplicas = `
+SELECT
+    pid,
+    coalesce(application_name, ''),
+    coalesce(host(client_addr), ''),
+    coalesce(state, ''),
+    coalesce(sync_state, ''),
+    coalesce(extract(epoch FROM write_lag) * 1000, 0),
+    coalesce(extract(epoch FROM flush_lag) * 1000, 0),
+    coalesce(extract(epoch FROM replay_lag) * 1000, 0),
+    coalesce(pg_wal_lsn_diff(` + currentWalLsn + `, replay_lsn), 0)
+FROM pg_stat_replication;
+`
+
+	SelectReplicationSlots = `
+SELECT
+    slot_name,
+    slot_type,
+    coalesce(database, ''),
+    active,
+    coalesce(pg_wal_lsn_diff(` + currentWalLsn + `, restart_lsn), 0),
+    coalesce(wal_status, '')
+FROM pg_replication_slots;
+`
+
+	// SelectReplicationSlotsPG12 has no wal_status, it was added in PostgreSQL 13
+	SelectReplicationSlotsPG12 = `
+SELECT
+    slot_name,
+    slot_type,
+    coalesce(database, ''),
+    active,
+    coalesce(pg_wal_lsn_diff(` + currentWalLsn + `, restart_lsn), 0),
+    ''
+FROM pg_replication_slots;
+`
+
+	currentWalLsn = `CASE WHEN pg_is_in_recovery() THEN pg_last_wal_receive_lsn() ELSE pg_current_wal_lsn() END`
+
 	SelectTablesBloat = `
 WITH constants AS (
 -- define some constants for sizes of things
@@ -98,6 +296,7 @@ no_stats AS (
 SELECT 
 	table_schema,
 	table_name,
+	relid,
 	n_live_tup::numeric as est_rows,
 	pg_table_size(relid)::numeric as table_size
 FROM information_schema.columns
@@ -153,7 +352,7 @@ table_estimates AS (
 -- make estimates of how large the table should be
 -- based on row and page size
 
-SELECT schemaname, tablename, bs,
+SELECT schemaname, tablename, pg_class.oid AS relid, bs,
 	reltuples::numeric as est_rows, relpages * bs as table_bytes,
 	CEIL((reltuples*
 		  (datahdr + nullhdr2 + 4 + ma -
@@ -172,7 +371,7 @@ estimates_with_toast AS (
 -- estimate based on 4 toast tuples per page because we dont have
 -- anything better.  also append the no_data tables
 
-SELECT schemaname, tablename,
+SELECT schemaname, tablename, relid,
 	TRUE as can_estimate,
 	est_rows,
 	table_bytes + ( coalesce(toast.relpages, 0) * bs ) as table_bytes,
@@ -187,7 +386,7 @@ table_estimates_plus AS (
 -- or whether we think it might be compressed
 
 SELECT current_database() as databasename,
-	schemaname, tablename, can_estimate,
+	schemaname, tablename, relid, can_estimate,
 	est_rows,
 	CASE WHEN table_bytes > 0
 			 THEN table_bytes::NUMERIC
@@ -204,7 +403,7 @@ SELECT current_database() as databasename,
 FROM estimates_with_toast
 UNION ALL
 SELECT current_database() as databasename,
-	table_schema, table_name, FALSE,
+	table_schema, table_name, relid, FALSE,
 	est_rows, table_size,
 	NULL::NUMERIC, NULL::NUMERIC
 FROM no_stats
@@ -213,7 +412,7 @@ bloat_data AS (
 -- do final math calculations and formatting
 
 select current_database() as databasename,
-	schemaname, tablename, can_estimate,
+	schemaname, tablename, relid, can_estimate,
 	table_bytes, round(table_bytes/(1024^2)::NUMERIC,3) as table_mb,
 	expected_bytes, round(expected_bytes/(1024^2)::NUMERIC,3) as expected_mb,
 	round(bloat_bytes*100/table_bytes) as pct_bloat,
@@ -223,6 +422,8 @@ FROM table_estimates_plus
 )
 -- filter output for bloated tables
 SELECT 
+	relid,
+	schemaname,
 	tablename,
 	est_rows,
 	pct_bloat,	--bloat in percent
@@ -311,7 +512,7 @@ SELECT maxalign, bs, nspname, index_name, reltuples,
 FROM index_item_sizes
 ), raw_bloat AS (
 
-SELECT current_database() as dbname, nspname, pg_class.relname AS table_name, index_name,
+SELECT current_database() as dbname, nspname, pg_class.relname AS table_name, index_name, index_oid,
 	bs*(index_aligned_est.relpages)::bigint AS totalbytes, expected,
 	CASE
 		WHEN index_aligned_est.relpages <= expected 
@@ -331,7 +532,7 @@ JOIN pg_stat_user_indexes AS stat ON index_aligned_est.index_oid = stat.indexrel
 ),
 format_bloat AS (
 
-SELECT dbname as database_name, nspname as schema_name, table_name, index_name,
+SELECT dbname as database_name, nspname as schema_name, table_name, index_name, index_oid,
         round(realbloat) as bloat_pct, round(wastedbytes/(1024^2)::NUMERIC) as bloat_mb,
         round(totalbytes/(1024^2)::NUMERIC,3) as index_mb,
         round(table_bytes/(1024^2)::NUMERIC,3) as table_mb,
@@ -344,6 +545,8 @@ FROM raw_bloat
 -- what shows up as bloated
 
 SELECT
+	index_oid,
+	schema_name,
 	table_name,
 	index_name, 
 	bloat_pct, 
@@ -353,6 +556,66 @@ SELECT
 	index_scans
 FROM format_bloat
 ORDER BY bloat_pct DESC;
+`
+
+	// SelectIndexes skips indexes of partitioned tables and their attached partition indexes,
+	// they can not be dropped or rebuilt on their own
+	SelectIndexes = `
+SELECT
+    i.indexrelid,
+    n.nspname,
+    t.relname,
+    c.relname,
+    am.amname,
+    i.indkey::text,
+    i.indclass::text,
+    i.indcollation::text,
+    i.indoption::text,
+    coalesce(pg_get_expr(i.indexprs, i.indrelid), ''),
+    coalesce(pg_get_expr(i.indpred, i.indrelid), ''),
+    i.indisunique,
+    i.indisprimary,
+    i.indisvalid,
+    EXISTS (SELECT 1 FROM pg_constraint con WHERE con.conindid = i.indexrelid),
+    coalesce(s.idx_scan, 0),
+    pg_relation_size(i.indexrelid),
+    pg_get_indexdef(i.indexrelid)
+FROM pg_index i
+    JOIN pg_class c ON c.oid = i.indexrelid
+    JOIN pg_class t ON t.oid = i.indrelid
+    JOIN pg_namespace n ON n.oid = c.relnamespace
+    JOIN pg_am am ON am.oid = c.relam
+    LEFT JOIN pg_stat_user_indexes s ON s.indexrelid = i.indexrelid
+WHERE c.relkind = 'i'
+  AND NOT EXISTS (SELECT 1 FROM pg_inherits inh WHERE inh.inhrelid = i.indexrelid)
+  AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+  AND n.nspname !~ '^pg_toast'
+ORDER BY n.nspname, t.relname, c.relname;
+`
+
+	SelectStatsReset = `
+SELECT stats_reset FROM pg_stat_database WHERE datname = current_database();
+`
+
+	SelectRelation = `
+SELECT c.relkind::text, pg_total_relation_size(c.oid)
+FROM pg_class c
+WHERE c.oid = to_regclass($1);
+`
+
+	SelectMaintenanceProgress = `
+SELECT phase, heap_blks_total, heap_blks_scanned FROM pg_stat_progress_vacuum WHERE pid = $1
+UNION ALL
+SELECT phase, sample_blks_total, sample_blks_scanned FROM pg_stat_progress_analyze WHERE pid = $1
+UNION ALL
+SELECT phase, blocks_total, blocks_done FROM pg_stat_progress_create_index WHERE pid = $1;
+`
+
+	// SelectMaintenanceProgressPG12 has no ANALYZE progress, pg_stat_progress_analyze was added in PostgreSQL 13
+	SelectMaintenanceProgressPG12 = `
+SELECT phase, heap_blks_total, heap_blks_scanned FROM pg_stat_progress_vacuum WHERE pid = $1
+UNION ALL
+SELECT phase, blocks_total, blocks_done FROM pg_stat_progress_create_index WHERE pid = $1;
 `
 
 	SelectDatabaseStat = `
@@ -376,5 +639,110 @@ SELECT
      ,idle_in_transaction_time
 FROM pg_stat_database 
 where datname = $1;
+`
+
+	// SelectDatabaseStatPG12 reports zero session times, they are tracked since PostgreSQL 14
+	SelectDatabaseStatPG12 = `
+SELECT
+     xact_commit --number of transactions that have been committed
+     ,xact_rollback
+     ,blks_read
+     ,blks_hit
+     ,tup_returned
+     ,tup_fetched
+     ,tup_inserted
+     ,tup_updated
+     ,tup_deleted
+     ,conflicts
+     ,temp_files
+     ,temp_bytes
+     ,deadlocks
+     ,blk_read_time
+     ,blk_write_time
+     ,0::float8 AS active_time
+     ,0::float8 AS idle_in_transaction_time
+FROM pg_stat_database 
+where datname = $1;
+`
+
+	SelectSettings = `
+SELECT
+	name, setting, vartype, unit, min_val, max_val, enumvals, context, pending_restart, source
+FROM pg_settings
+`
+
+	SelectSettingsByNames = SelectSettings + `WHERE name = ANY($1);`
+
+	// SelectAutoConfSettings returns values written by ALTER SYSTEM,
+	// later lines of postgresql.auto.conf override earlier ones
+	SelectAutoConfSettings = `
+SELECT name, setting, applied, error
+FROM pg_file_settings
+WHERE sourcefile LIKE '%postgresql.auto.conf' AND name = ANY($1)
+ORDER BY seqno;
+`
+
+	SelectAutoConf = `
+SELECT name, setting
+FROM pg_file_settings
+WHERE sourcefile LIKE '%postgresql.auto.conf'
+ORDER BY seqno;
+`
+
+	// SelectTopStatements aggregates pg_stat_statements of the current database per queryid,
+	// stddev is pooled from per-entry mean and stddev. The order column is substituted by
+	// CollectTopStatements from a fixed list, never from user input.
+	SelectTopStatements     = topStatementsSelect + `pg_stat_statements` + topStatementsWhere
+	SelectTopStatementsPG12 = topStatementsSelect + pgStatStatementsPG12 + topStatementsWhere
+
+	topStatementsSelect = `
+WITH statements AS (
+SELECT
+	queryid,
+	min(query) AS query,
+	sum(calls) AS calls,
+	sum(total_exec_time) AS total_exec_time,
+	sum(calls * (stddev_exec_time ^ 2 + mean_exec_time ^ 2)) AS sum_sq_exec_time,
+	sum(rows) AS rows,
+	sum(shared_blks_hit) AS shared_blks_hit,
+	sum(shared_blks_read) AS shared_blks_read,
+	sum(shared_blks_dirtied) AS shared_blks_dirtied,
+	sum(shared_blks_written) AS shared_blks_written,
+	sum(local_blks_hit) AS local_blks_hit,
+	sum(local_blks_read) AS local_blks_read,
+	sum(local_blks_dirtied) AS local_blks_dirtied,
+	sum(local_blks_written) AS local_blks_written,
+	sum(temp_blks_read) AS temp_blks_read,
+	sum(temp_blks_written) AS temp_blks_written,
+	sum(wal_bytes) AS wal_bytes
+FROM `
+	topStatementsWhere = `
+WHERE dbid = (SELECT oid FROM pg_database WHERE datname = current_database())
+	AND queryid IS NOT NULL
+GROUP BY queryid
+)
+SELECT
+	queryid,
+	query,
+	calls,
+	total_exec_time,
+	total_exec_time / calls AS mean_exec_time,
+	sqrt(greatest(sum_sq_exec_time / calls - (total_exec_time / calls) ^ 2, 0)) AS stddev_exec_time,
+	rows,
+	shared_blks_hit,
+	shared_blks_read,
+	shared_blks_dirtied,
+	shared_blks_written,
+	local_blks_hit,
+	local_blks_read,
+	local_blks_dirtied,
+	local_blks_written,
+	temp_blks_read,
+	temp_blks_written,
+	wal_bytes
+FROM statements
+WHERE calls > 0
+ORDER BY %s DESC
+LIMIT $1;
 `
 )
@@ -0,0 +1,151 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"postgresHelper/internal/model"
+)
+
+// minServerVersion is the oldest supported server_version_num.
+const minServerVersion = 120000
+
+const (
+	serverVersion13 = 130000
+	serverVersion14 = 140000
+	serverVersion16 = 160000
+	serverVersion17 = 170000
+	serverVersion18 = 180000
+)
+
+type queryID int
+
+const (
+	queryTablesInfo queryID = iota
+	querySharedBufferHitRate
+	queryQueryTypesDistribution
+	queryLockingInformation
+	querySessionWaits
+	queryWalWriteAndFlushStat
+	queryTablesBloat
+	queryIndexesBloat
+	queryDatabaseStat
+	querySettings
+	querySettingsByNames
+	queryAutoConfSettings
+	queryAutoConf
+	queryTopStatements
+	queryWalStat
+	queryIOStats
+	queryInRecovery
+	queryReplicas
+	queryReplicationSlots
+	queryIndexes
+	queryStatsReset
+	queryRelation
+	queryMaintenanceProgress
+)
+
+// queryVariant is used on servers with server_version_num of at least minVersion.
+type queryVariant struct {
+	minVersion int
+	query      string
+}
+
+// queryRegistry lists variants of every query from the newest server version to the oldest.
+// All variants of a query return the same columns, so they scan into the same model struct.
+// Queries of views missing in older versions have no variant for them.
+var queryRegistry = map[queryID][]queryVariant{
+	queryTablesInfo:          {{minServerVersion, SelectTablesInfo}},
+	querySharedBufferHitRate: {{minServerVersion, SelectSharedBufferHitRate}},
+	queryQueryTypesDistribution: {
+		{serverVersion13, SelectQueryTypesDistribution},
+		{minServerVersion, SelectQueryTypesDistributionPG12},
+	},
+	queryLockingInformation: {
+		{serverVersion14, SelectLockingInformation},
+		{minServerVersion, SelectLockingInformationPG12},
+	},
+	querySessionWaits: {{minServerVersion, SelectSessionWaits}},
+	queryWalWriteAndFlushStat: {
+		{serverVersion17, SelectWalWriteAndFlushStatPG17},
+		{minServerVersion, SelectWalWriteAndFlushStat},
+	},
+	queryTablesBloat:  {{minServerVersion, SelectTablesBloat}},
+	queryIndexesBloat: {{minServerVersion, SelectIndexesBloat}},
+	queryDatabaseStat: {
+		{serverVersion14, SelectDatabaseStat},
+		{minServerVersion, SelectDatabaseStatPG12},
+	},
+	querySettings:         {{minServerVersion, SelectSettings}},
+	querySettingsByNames:  {{minServerVersion, SelectSettingsByNames}},
+	queryAutoConfSettings: {{minServerVersion, SelectAutoConfSettings}},
+	queryAutoConf:         {{minServerVersion, SelectAutoConf}},
+	queryTopStatements: {
+		{serverVersion13, SelectTopStatements},
+		{minServerVersion, SelectTopStatementsPG12},
+	},
+	queryWalStat: {
+		{serverVersion18, SelectWalStatPG18},
+		{serverVersion14, SelectWalStat},
+	},
+	queryIOStats: {
+		{serverVersion18, SelectIOStatsPG18},
+		{serverVersion16, SelectIOStats},
+	},
+	queryInRecovery: {{minServerVersion, SelectInRecovery}},
+	queryReplicas:   {{minServerVersion, SelectReplicas}},
+	queryReplicationSlots: {
+		{serverVersion13, SelectReplicationSlots},
+		{minServerVersion, SelectReplicationSlotsPG12},
+	},
+	queryIndexes:    {{minServerVersion, SelectIndexes}},
+	queryStatsReset: {{minServerVersion, SelectStatsReset}},
+	queryRelation:   {{minServerVersion, SelectRelation}},
+	queryMaintenanceProgress: {
+		{serverVersion13, SelectMaintenanceProgress},
+		{minServerVersion, SelectMaintenanceProgressPG12},
+	},
+}
+
+// resolveQueries picks the variant of every query for the server version,
+// queries the server does not support are left out.
+func resolveQueries(serverVersion int) (map[queryID]string, error) {
+	if serverVersion < minServerVersion {
+		return nil, fmt.Errorf("server version %d is not supported, the oldest supported is %d", serverVersion, minServerVersion)
+	}
+
+	queries := make(map[queryID]string, len(queryRegistry))
+	for id, variants := range queryRegistry {
+		for _, variant := range variants {
+			if serverVersion >= variant.minVersion {
+				queries[id] = variant.query
+				break
+			}
+		}
+	}
+	return queries, nil
+}
+
+// query returns the query variant for the server or model.ErrNotSupported.
+func (i *Implementation) query(id queryID) (string, error) {
+	query, ok := i.queries[id]
+	if !ok {
+		return "", fmt.Errorf("%w by server version %d", model.ErrNotSupported, i.serverVersion)
+	}
+	return query, nil
+}
+
+func (i *Implementation) detectServerVersion(ctx context.Context) (int, error) {
+	var serverVersion int
+	err := i.db.QueryRowContext(ctx, "SELECT current_setting('server_version_num')::int").Scan(&serverVersion)
+	if err != nil {
+		return 0, fmt.Errorf("row.Scan: %w", err)
+	}
+	return serverVersion, nil
+}
+
+// ServerVersion returns server_version_num detected when the collector was created.
+func (i *Implementation) ServerVersion() int {
+	return i.serverVersion
+}
@@ -0,0 +1,107 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"postgresHelper/internal/model"
+)
+
+// CollectReplication returns the role of the server, WAL senders to its replicas and replication slots.
+func (i *Implementation) CollectReplication(ctx context.Context) (model.ReplicationState, model.Scope, error) {
+	query, err := i.query(queryInRecovery)
+	if err != nil {
+		return model.ReplicationState{}, model.Unspecified, err
+	}
+
+	var state model.ReplicationState
+	err = i.db.QueryRowContext(ctx, query).Scan(&state.InRecovery)
+	if err != nil {
+		return model.ReplicationState{}, model.Unspecified, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	state.Replicas, err = i.collectReplicas(ctx)
+	if err != nil {
+		return model.ReplicationState{}, model.Unspecified, fmt.Errorf("i.collectReplicas: %w", err)
+	}
+
+	state.Slots, err = i.collectReplicationSlots(ctx)
+	if err != nil {
+		return model.ReplicationState{}, model.Unspecified, fmt.Errorf("i.collectReplicationSlots: %w", err)
+	}
+
+	return state, model.General, nil
+}
+
+func (i *Implementation) collectReplicas(ctx context.Context) ([]model.Replica, error) {
+	query, err := i.query(queryReplicas)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := i.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var replicas []model.Replica
+	for rows.Next() {
+		var replica model.Replica
+		err := rows.Scan(
+			&replica.Pid,
+			&replica.ApplicationName,
+			&replica.ClientAddr,
+			&replica.State,
+			&replica.SyncState,
+			&replica.WriteLag,
+			&replica.FlushLag,
+			&replica.ReplayLag,
+			&replica.ReplayLagBytes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		replicas = append(replicas, replica)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return replicas, nil
+}
+
+func (i *Implementation) collectReplicationSlots(ctx context.Context) ([]model.ReplicationSlot, error) {
+	query, err := i.query(queryReplicationSlots)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := i.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var slots []model.ReplicationSlot
+	for rows.Next() {
+		var slot model.ReplicationSlot
+		err := rows.Scan(
+			&slot.SlotName,
+			&slot.SlotType,
+			&slot.Database,
+			&slot.Active,
+			&slot.RetainedWalBytes,
+			&slot.WalStatus,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		slots = append(slots, slot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return slots, nil
+}
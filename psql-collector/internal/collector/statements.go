@@ -0,0 +1,66 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"postgresHelper/internal/model"
+)
+
+var statementsOrderColumns = map[model.StatementsOrder]string{
+	model.OrderByTotalExecTime:   "total_exec_time",
+	model.OrderByCalls:           "calls",
+	model.OrderByMeanExecTime:    "mean_exec_time",
+	model.OrderByRows:            "rows",
+	model.OrderBySharedBlksRead:  "shared_blks_read",
+	model.OrderByTempBlksWritten: "temp_blks_written",
+	model.OrderByWalBytes:        "wal_bytes",
+}
+
+// CollectTopStatements returns up to limit statements of the current database sorted by orderBy.
+func (i *Implementation) CollectTopStatements(ctx context.Context, limit int, orderBy model.StatementsOrder) ([]model.StatementStat, error) {
+	column, ok := statementsOrderColumns[orderBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown statements order %d", orderBy)
+	}
+
+	rows, err := i.db.QueryContext(ctx, fmt.Sprintf(i.queries[queryTopStatements], column), limit)
+	if err != nil {
+		return nil, fmt.Errorf("i.db.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []model.StatementStat
+	for rows.Next() {
+		var stat model.StatementStat
+		err := rows.Scan(
+			&stat.QueryID,
+			&stat.Query,
+			&stat.Calls,
+			&stat.TotalExecTime,
+			&stat.MeanExecTime,
+			&stat.StddevExecTime,
+			&stat.Rows,
+			&stat.SharedBlksHit,
+			&stat.SharedBlksRead,
+			&stat.SharedBlksDirtied,
+			&stat.SharedBlksWritten,
+			&stat.LocalBlksHit,
+			&stat.LocalBlksRead,
+			&stat.LocalBlksDirtied,
+			&stat.LocalBlksWritten,
+			&stat.TempBlksRead,
+			&stat.TempBlksWritten,
+			&stat.WalBytes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,203 @@
+package collector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"postgresHelper/internal/model"
+)
+
+// tableOptionsLockTimeout keeps ALTER TABLE from queueing behind long transactions
+// and blocking the workload while it waits for the lock.
+const tableOptionsLockTimeout = "5s"
+
+type tableOptionType int
+
+const (
+	tableOptionInteger tableOptionType = iota
+	tableOptionReal
+	tableOptionBool
+)
+
+// tableOptionSpec is the type and range PostgreSQL accepts for a storage parameter.
+type tableOptionSpec struct {
+	varType  tableOptionType
+	min, max float64
+}
+
+var tableOptionSpecs = map[string]tableOptionSpec{
+	"fillfactor":                          {tableOptionInteger, 10, 100},
+	"autovacuum_enabled":                  {varType: tableOptionBool},
+	"autovacuum_vacuum_threshold":         {tableOptionInteger, 0, 2147483647},
+	"autovacuum_vacuum_scale_factor":      {tableOptionReal, 0, 100},
+	"autovacuum_analyze_threshold":        {tableOptionInteger, 0, 2147483647},
+	"autovacuum_analyze_scale_factor":     {tableOptionReal, 0, 100},
+	"autovacuum_vacuum_cost_delay":        {tableOptionReal, 0, 100},
+	"autovacuum_vacuum_cost_limit":        {tableOptionInteger, 1, 10000},
+	"autovacuum_freeze_min_age":           {tableOptionInteger, 0, 1000000000},
+	"autovacuum_freeze_max_age":           {tableOptionInteger, 100000, 2000000000},
+	"autovacuum_freeze_table_age":         {tableOptionInteger, 0, 2000000000},
+	"autovacuum_multixact_freeze_max_age": {tableOptionInteger, 10000, 2000000000},
+}
+
+// SetTableOptions validates storage parameters and sets them with ALTER TABLE in a single transaction,
+// so either all tables are changed or, on failure, none of them. It reports per parameter whether
+// pg_class.reloptions holds the requested value afterwards.
+func (i *Implementation) SetTableOptions(ctx context.Context, tables []model.TableOptions) ([]model.TableOptionApplyResult, error) {
+	names := make([]string, 0, len(tables))
+	values := make([][]string, 0, len(tables))
+	statements := make([]string, 0, len(tables))
+	for _, table := range tables {
+		name := model.QualifiedName(table.SchemaName, table.RelationName)
+		if slices.Contains(names, name) {
+			return nil, fmt.Errorf("%w: table %s is specified more than once", model.ErrInvalidTableOption, name)
+		}
+		if len(table.Options) == 0 {
+			return nil, fmt.Errorf("%w: table %s: no options", model.ErrInvalidTableOption, name)
+		}
+		names = append(names, name)
+
+		assignments := make([]string, 0, len(table.Options))
+		tableValues := make([]string, 0, len(table.Options))
+		for optionIdx, option := range table.Options {
+			if slices.ContainsFunc(table.Options[:optionIdx], func(o model.TableOption) bool { return o.Name == option.Name }) {
+				return nil, fmt.Errorf("%w: table %s: option %s is specified more than once", model.ErrInvalidTableOption, name, option.Name)
+			}
+
+			value, err := validateTableOption(option)
+			if err != nil {
+				return nil, fmt.Errorf("%w: table %s: option %s: %v", model.ErrInvalidTableOption, name, option.Name, err)
+			}
+			tableValues = append(tableValues, value)
+			assignments = append(assignments, fmt.Sprintf("%s = %s", option.Name, value))
+		}
+		values = append(values, tableValues)
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET (%s)", name, strings.Join(assignments, ", ")))
+	}
+
+	for _, name := range names {
+		var oid sql.NullString
+		err := i.db.QueryRowContext(ctx, "SELECT to_regclass($1)::oid", name).Scan(&oid)
+		if err != nil {
+			return nil, fmt.Errorf("row.Scan: %w", err)
+		}
+		if !oid.Valid {
+			return nil, fmt.Errorf("%w: unknown table %s", model.ErrInvalidTableOption, name)
+		}
+	}
+
+	err := i.alterTables(ctx, statements)
+	if err != nil {
+		return nil, fmt.Errorf("i.alterTables: %w", err)
+	}
+
+	var results []model.TableOptionApplyResult
+	for idx, table := range tables {
+		reloptions, err := i.loadReloptions(ctx, names[idx])
+		if err != nil {
+			return nil, fmt.Errorf("i.loadReloptions: %w", err)
+		}
+
+		for optionIdx, option := range table.Options {
+			result := model.TableOptionApplyResult{
+				SchemaName:     table.SchemaName,
+				RelationName:   table.RelationName,
+				Name:           option.Name,
+				RequestedValue: values[idx][optionIdx],
+				EffectiveValue: reloptions[option.Name],
+				Status:         model.KnobApplied,
+			}
+			if result.EffectiveValue != result.RequestedValue {
+				result.Status = model.KnobRejected
+				result.Error = "reloptions do not hold the requested value"
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// alterTables runs statements in a transaction and rolls it back when any of them fails.
+func (i *Implementation) alterTables(ctx context.Context, statements []string) error {
+	tx, err := i.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("db.BeginTx: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL lock_timeout = %s", pq.QuoteLiteral(tableOptionsLockTimeout)))
+	if err != nil {
+		return errors.Join(fmt.Errorf("tx.ExecContext: %w", err), tx.Rollback())
+	}
+
+	for _, statement := range statements {
+		_, err := tx.ExecContext(ctx, statement)
+		if err != nil {
+			return errors.Join(fmt.Errorf("tx.ExecContext: %w", err), tx.Rollback())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+	return nil
+}
+
+func (i *Implementation) loadReloptions(ctx context.Context, name string) (map[string]string, error) {
+	var reloptions pq.StringArray
+	err := i.db.QueryRowContext(ctx, "SELECT coalesce(reloptions, '{}') FROM pg_class WHERE oid = to_regclass($1)", name).Scan(&reloptions)
+	if err != nil {
+		return nil, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	options := make(map[string]string, len(reloptions))
+	for _, option := range reloptions {
+		optionName, value, _ := strings.Cut(option, "=")
+		options[optionName] = value
+	}
+	return options, nil
+}
+
+// validateTableOption checks the value against the parameter type and range
+// and returns it in the form PostgreSQL keeps in reloptions.
+func validateTableOption(option model.TableOption) (string, error) {
+	spec, ok := tableOptionSpecs[option.Name]
+	if !ok {
+		return "", fmt.Errorf("unknown option")
+	}
+
+	switch spec.varType {
+	case tableOptionBool:
+		switch strings.ToLower(option.Value) {
+		case "on", "true", "yes", "1":
+			return "true", nil
+		case "off", "false", "no", "0":
+			return "false", nil
+		}
+		return "", fmt.Errorf("value %q is not a boolean", option.Value)
+	case tableOptionInteger, tableOptionReal:
+		number, err := strconv.ParseFloat(option.Value, 64)
+		if err != nil {
+			return "", fmt.Errorf("value %q is not a number", option.Value)
+		}
+		if spec.varType == tableOptionInteger && number != float64(int64(number)) {
+			return "", fmt.Errorf("value %q is not an integer", option.Value)
+		}
+		if number < spec.min {
+			return "", fmt.Errorf("value %v is less than minimum %v", number, spec.min)
+		}
+		if number > spec.max {
+			return "", fmt.Errorf("value %v is greater than maximum %v", number, spec.max)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported option type")
+	}
+}
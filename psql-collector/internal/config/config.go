@@ -7,14 +7,23 @@ import (
 	"log"
 	"os"
 	"postgresHelper/lib/grpc_server"
+	"postgresHelper/lib/http_server"
+	"time"
 )
 
 const pathToConfig = "config/config.yaml"
 
 type Config struct {
 	GRPC    grpc_server.GRPCConfig `yaml:"grpc"`
+	Metrics Metrics                `yaml:"metrics"`
 	PG      Postgres               `yaml:"postgres"`
 	Pgbench Pgbench                `yaml:"pgbench"`
+	Loader  LoadGenerator          `yaml:"load_generator"`
+	Knobs   KnobPolicy             `yaml:"knob_policy"`
+	Tables  TableOptionPolicy      `yaml:"table_option_policy"`
+	Waits   WaitSampler            `yaml:"wait_sampler"`
+	History History                `yaml:"history"`
+	Snaps   KnobSnapshots          `yaml:"knob_snapshots"`
 }
 
 type Postgres struct {
@@ -36,6 +45,106 @@ type Pgbench struct {
 	NoVacuum     bool   `yaml:"no_vacuum"`
 	Scale        int64  `yaml:"scale"`
 	ForeignKeys  bool   `yaml:"foreign_keys"`
+
+	// Builtins are built-in scripts run with -b, pgbench runs tpcb-like when no script is set
+	Builtins []PgbenchScript `yaml:"builtins"`
+	// Scripts are custom script files run with -f
+	Scripts []PgbenchScript `yaml:"scripts"`
+	// QueryMode is passed with -M: simple, extended or prepared
+	QueryMode string `yaml:"query_mode"`
+	// Rate is the target transactions per second passed with -R, zero means unlimited
+	Rate float64 `yaml:"rate"`
+	// Variables are passed with -D
+	Variables map[string]string `yaml:"variables"`
+}
+
+type PgbenchScript struct {
+	// Name is a built-in script name or a script file path
+	Name string `yaml:"name"`
+	// Weight is the relative frequency of the script, 1 when not set
+	Weight int64 `yaml:"weight"`
+}
+
+// LoadGenerator selects what produces load for InitLoad and CollectExternalMetrics.
+type LoadGenerator struct {
+	// Engine is pgbench, the default, native for the in-process generator or replay
+	Engine string `yaml:"engine"`
+	// Mix is the YAML file with transaction templates of the native generator,
+	// it uses clients, duration, query mode, rate and variables of the pgbench section
+	Mix string `yaml:"mix"`
+
+	// Log is the file replayed by the replay engine
+	Log string `yaml:"log"`
+	// Format of Log: csvlog, jsonlog or capture, detected from the .csv and .json extensions when not set
+	Format string `yaml:"format"`
+	// Timezone is the log_timezone of the captured server, e.g. Europe/Berlin, zone abbreviations of
+	// csvlog and jsonlog timestamps are resolved in it, UTC when not set
+	Timezone string `yaml:"timezone"`
+	// Speed scales the captured timing, 2 replays twice as fast, 1 when not set
+	Speed float64 `yaml:"speed"`
+	// Database limits the replay to statements of the captured database, all when empty
+	Database string `yaml:"database"`
+	// MaxSessions caps sessions replayed at once, each holds a connection, 50 when not set
+	MaxSessions int `yaml:"max_sessions"`
+	// Duration bounds a replay, 10m when not set
+	Duration time.Duration `yaml:"duration"`
+}
+
+// KnobPolicy restricts knobs exposed by CollectKnobs and accepted by SetKnobs.
+type KnobPolicy struct {
+	// Allow lists tunable knobs, when empty every knob that is not denied is tunable
+	Allow []string `yaml:"allow"`
+	// Deny lists knobs that can never be changed, it takes precedence over Allow
+	Deny []string `yaml:"deny"`
+	// Bounds narrow pg_settings limits, values may carry units such as "4GB" or "10s"
+	Bounds map[string]KnobBounds `yaml:"bounds"`
+}
+
+// TableOptionPolicy restricts storage parameters proposed by RecommendTableOptions and accepted by SetTableOptions.
+type TableOptionPolicy struct {
+	// Allow lists parameters that may be set, when empty every parameter that is not denied may be set
+	Allow []string `yaml:"allow"`
+	// Deny lists parameters that can never be set, it takes precedence over Allow
+	Deny []string `yaml:"deny"`
+}
+
+type KnobBounds struct {
+	Min string `yaml:"min"`
+	Max string `yaml:"max"`
+}
+
+// KnobSnapshots configures where knob snapshots are kept, so a baseline survives collector restarts.
+type KnobSnapshots struct {
+	// Path is the JSON file snapshots are saved to, they are kept in memory only when empty
+	Path string `yaml:"path"`
+}
+
+// WaitSampler configures sampling of pg_stat_activity wait events.
+type WaitSampler struct {
+	Interval time.Duration `yaml:"interval"`
+	// Capacity is the number of samples kept in memory
+	Capacity int `yaml:"capacity"`
+	// Window is the period wait event metrics of CollectInternalMetrics are aggregated over
+	Window time.Duration `yaml:"window"`
+}
+
+// Metrics configures the Prometheus exporter.
+type Metrics struct {
+	http_server.HTTPConfig `yaml:",inline"`
+	// Bloat adds per-table and per-index bloat estimates to every scrape, they scan every table and index
+	Bloat bool `yaml:"bloat"`
+}
+
+// History configures the background collection of knobs and metrics kept in memory.
+type History struct {
+	Interval time.Duration `yaml:"interval"`
+	// Groups maps metric groups to how often they are sampled, intervals shorter than Interval mean every Interval.
+	// Groups that are not listed are not sampled, cheap groups are sampled every Interval when it is empty
+	Groups map[string]time.Duration `yaml:"groups"`
+	// Retention is the age of the oldest kept sample
+	Retention time.Duration `yaml:"retention"`
+	// Capacity is the maximum number of kept samples
+	Capacity int `yaml:"capacity"`
 }
 
 func (pg *Postgres) ConnectionString() string {
@@ -0,0 +1,299 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const (
+	namespace     = "postgres"
+	scrapeTimeout = 30 * time.Second
+	contentType   = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+type Selector interface {
+	ListMetrics(ctx context.Context) ([]model.InternalMetric, error)
+	ListBloatMetrics(ctx context.Context) ([]model.InternalMetric, error)
+}
+
+type Knobs interface {
+	GetKnobs() []model.Knob
+}
+
+type Loads interface {
+	LastLoad() (model.ExternalMetric, time.Time, bool)
+}
+
+// Implementation serves metrics in the Prometheus text exposition format.
+type Implementation struct {
+	selector  Selector
+	knobs     Knobs
+	loads     Loads
+	startedAt time.Time
+	// bloat adds bloat estimates to scrapes, they scan every table and index
+	bloat bool
+
+	mu           sync.Mutex
+	scrapes      int64
+	scrapeErrors int64
+}
+
+func New(selector Selector, knobs Knobs, loads Loads, cfg config.Metrics) *Implementation {
+	return &Implementation{
+		selector:  selector,
+		knobs:     knobs,
+		loads:     loads,
+		startedAt: time.Now(),
+		bloat:     cfg.Bloat,
+	}
+}
+
+func (i *Implementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), scrapeTimeout)
+	defer cancel()
+
+	families := newFamilies()
+
+	start := time.Now()
+	metrics, err := i.listMetrics(ctx)
+	if err != nil {
+		log.Println(err)
+	}
+	i.addInternalMetrics(families, metrics)
+	i.addKnobs(families)
+	i.addLoad(families)
+	i.addSelfMetrics(families, time.Since(start), err == nil)
+
+	var buf bytes.Buffer
+	families.write(&buf)
+
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (i *Implementation) listMetrics(ctx context.Context) ([]model.InternalMetric, error) {
+	metrics, err := i.selector.ListMetrics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("selector.ListMetrics: %w", err)
+	}
+	if !i.bloat {
+		return metrics, nil
+	}
+
+	bloat, err := i.selector.ListBloatMetrics(ctx)
+	if err != nil {
+		return metrics, fmt.Errorf("selector.ListBloatMetrics: %w", err)
+	}
+	return append(metrics, bloat...), nil
+}
+
+func (i *Implementation) addInternalMetrics(families *families, metrics []model.InternalMetric) {
+	for _, metric := range metrics {
+		value, ok := toFloat(metric.Value)
+		if !ok {
+			continue
+		}
+
+		name := namespace + "_" + toSnakeCase(metric.Name)
+		metricType := "gauge"
+		if metric.Counter {
+			name += "_total"
+			metricType = "counter"
+		}
+
+		labels := make([]label, 0, len(metric.Labels))
+		for key, labelValue := range metric.Labels {
+			labels = append(labels, label{name: toSnakeCase(key), value: labelValue})
+		}
+		families.add(name, metricType, "", labels, value)
+	}
+}
+
+func (i *Implementation) addKnobs(families *families) {
+	for _, knob := range i.knobs.GetKnobs() {
+		value, ok := knob.Value.(float64)
+		if !ok {
+			continue
+		}
+		families.add(namespace+"_knob", "gauge", "Numeric PostgreSQL settings in the unit of pg_settings", []label{
+			{name: "name", value: knob.Name},
+			{name: "unit", value: knob.Unit},
+		}, value)
+	}
+}
+
+func (i *Implementation) addLoad(families *families) {
+	load, loadedAt, ok := i.loads.LastLoad()
+	if !ok {
+		return
+	}
+	families.add(namespace+"_load_tps", "gauge", "Transactions per second of the latest load", nil, load.Tps)
+	families.add(namespace+"_load_latency_milliseconds", "gauge", "Average latency of the latest load", nil, load.Latency)
+	if load.LatencyP50 > 0 {
+		for _, q := range []struct {
+			quantile string
+			value    float64
+		}{{"0.5", load.LatencyP50}, {"0.95", load.LatencyP95}, {"0.99", load.LatencyP99}} {
+			families.add(namespace+"_load_latency_quantile_milliseconds", "gauge", "Latency percentiles of the latest load", []label{{name: "quantile", value: q.quantile}}, q.value)
+		}
+	}
+	families.add(namespace+"_load_transactions", "gauge", "Transactions completed by the latest load", nil, float64(load.Transactions))
+	families.add(namespace+"_load_errors", "gauge", "Transactions failed in the latest load", nil, float64(load.Errors))
+	families.add(namespace+"_load_timestamp_seconds", "gauge", "When the latest load finished", nil, float64(loadedAt.UnixNano())/1e9)
+}
+
+func (i *Implementation) addSelfMetrics(families *families, scrapeTime time.Duration, succeeded bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.scrapes++
+	if !succeeded {
+		i.scrapeErrors++
+	}
+
+	up := 0.0
+	if succeeded {
+		up = 1
+	}
+
+	families.add("collector_up", "gauge", "Whether metrics were collected from PostgreSQL by this scrape", nil, up)
+	families.add("collector_scrape_duration_seconds", "gauge", "Time spent collecting metrics from PostgreSQL", nil, scrapeTime.Seconds())
+	families.add("collector_scrapes_total", "counter", "Scrapes served since start", nil, float64(i.scrapes))
+	families.add("collector_scrape_errors_total", "counter", "Scrapes that failed to collect metrics from PostgreSQL", nil, float64(i.scrapeErrors))
+	families.add("collector_start_time_seconds", "gauge", "Start time of the collector since unix epoch", nil, float64(i.startedAt.UnixNano())/1e9)
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+type family struct {
+	metricType string
+	help       string
+	series     map[string]float64
+}
+
+// families groups samples by metric name, since the exposition format requires all
+// samples of a metric to follow its TYPE line.
+type families struct {
+	byName map[string]*family
+}
+
+func newFamilies() *families {
+	return &families{byName: make(map[string]*family)}
+}
+
+// add appends a sample, the first sample of a series wins when series repeat.
+func (f *families) add(name, metricType, help string, labels []label, value float64) {
+	fam, ok := f.byName[name]
+	if !ok {
+		fam = &family{metricType: metricType, help: help, series: make(map[string]float64)}
+		f.byName[name] = fam
+	}
+
+	series := formatLabels(labels)
+	if _, ok := fam.series[series]; ok {
+		return
+	}
+	fam.series[series] = value
+}
+
+func (f *families) write(buf *bytes.Buffer) {
+	names := make([]string, 0, len(f.byName))
+	for name := range f.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fam := f.byName[name]
+		if fam.help != "" {
+			fmt.Fprintf(buf, "# HELP %s %s\n", name, fam.help)
+		}
+		fmt.Fprintf(buf, "# TYPE %s %s\n", name, fam.metricType)
+
+		series := make([]string, 0, len(fam.series))
+		for s := range fam.series {
+			series = append(series, s)
+		}
+		sort.Strings(series)
+
+		for _, s := range series {
+			fmt.Fprintf(buf, "%s%s %s\n", name, s, strconv.FormatFloat(fam.series[s], 'g', -1, 64))
+		}
+	}
+}
+
+func formatLabels(labels []label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].name < labels[j].name
+	})
+
+	parts := make([]string, 0, len(labels))
+	for _, l := range labels {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, l.name, labelValueReplacer.Replace(l.value)))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case sql.NullTime:
+		return float64(v.Time.UnixNano()) / 1e9, v.Valid
+	case sql.Null[time.Time]:
+		return float64(v.V.UnixNano()) / 1e9, v.Valid
+	default:
+		return 0, false
+	}
+}
+
+// toSnakeCase converts field names such as NumOfIndexScans or WALBytes to num_of_index_scans and wal_bytes.
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			b.WriteRune('_')
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,134 @@
+package loadgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// inlineArgs replaces $1, $2, ... with quoted literals of args, like pgbench does in the simple query mode.
+// Placeholders inside string literals, quoted identifiers, dollar-quoted bodies and comments are
+// part of the statement text and are left as they are, so are placeholders without an argument.
+func inlineArgs(statement string, args []any) string {
+	var b strings.Builder
+	for i := 0; i < len(statement); {
+		if n := quotedLength(statement, i); n > 0 {
+			b.WriteString(statement[i : i+n])
+			i += n
+			continue
+		}
+
+		if statement[i] == '$' && (i == 0 || !isIdentChar(statement[i-1])) {
+			j := i + 1
+			for j < len(statement) && isDigit(statement[j]) {
+				j++
+			}
+			if n, err := strconv.Atoi(statement[i+1 : j]); err == nil && n >= 1 && n <= len(args) {
+				if args[n-1] == nil {
+					b.WriteString("NULL")
+				} else {
+					b.WriteString(pq.QuoteLiteral(fmt.Sprint(args[n-1])))
+				}
+				i = j
+				continue
+			}
+		}
+
+		b.WriteByte(statement[i])
+		i++
+	}
+	return b.String()
+}
+
+// quotedLength returns the length of the literal, quoted identifier, dollar-quoted body or comment
+// starting at statement[i], or 0 when none starts there. Unterminated ones run to the end of statement.
+func quotedLength(statement string, i int) int {
+	s := statement[i:]
+	afterIdent := i > 0 && isIdentChar(statement[i-1])
+
+	switch {
+	case s[0] == '\'':
+		return quotedUntil(s, '\'', false)
+	case (s[0] == 'E' || s[0] == 'e') && len(s) > 1 && s[1] == '\'' && !afterIdent:
+		return 1 + quotedUntil(s[1:], '\'', true)
+	case s[0] == '"':
+		return quotedUntil(s, '"', false)
+	case strings.HasPrefix(s, "--"):
+		if end := strings.IndexByte(s, '\n'); end >= 0 {
+			return end + 1
+		}
+		return len(s)
+	case strings.HasPrefix(s, "/*"):
+		return blockCommentLength(s)
+	case s[0] == '$' && !afterIdent:
+		return dollarQuotedLength(s)
+	default:
+		return 0
+	}
+}
+
+// quotedUntil returns the length of a region opened by quote at s[0] and closed by an undoubled quote,
+// backslashes escape the next character when escapes is set.
+func quotedUntil(s string, quote byte, escapes bool) int {
+	for i := 1; i < len(s); i++ {
+		switch {
+		case escapes && s[i] == '\\':
+			i++
+		case s[i] == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+		case s[i] == quote:
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// blockCommentLength returns the length of a comment opened at s[0], block comments nest in PostgreSQL.
+func blockCommentLength(s string) int {
+	depth := 0
+	for i := 0; i+1 < len(s); i++ {
+		switch {
+		case s[i] == '/' && s[i+1] == '*':
+			depth++
+			i++
+		case s[i] == '*' && s[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// dollarQuotedLength returns the length of a $tag$ ... $tag$ body opened at s[0], or 0 when s starts
+// with a placeholder or a lone dollar sign instead.
+func dollarQuotedLength(s string) int {
+	end := 1
+	for end < len(s) && s[end] != '$' {
+		if !isIdentChar(s[end]) || (end == 1 && isDigit(s[end])) {
+			return 0
+		}
+		end++
+	}
+	if end == len(s) {
+		return 0
+	}
+
+	tag := s[:end+1]
+	if closing := strings.Index(s[len(tag):], tag); closing >= 0 {
+		return len(tag) + closing + len(tag)
+	}
+	return len(s)
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
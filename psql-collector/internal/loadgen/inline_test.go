@@ -0,0 +1,41 @@
+package loadgen
+
+import "testing"
+
+func TestInlineArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		args      []any
+		want      string
+	}{
+		{name: "no placeholders", statement: "SELECT 1", want: "SELECT 1"},
+		{name: "numbers and strings", statement: "UPDATE t SET a = $1 WHERE id = $2", args: []any{"x", int64(42)}, want: "UPDATE t SET a = 'x' WHERE id = '42'"},
+		{name: "null", statement: "SELECT $1", args: []any{nil}, want: "SELECT NULL"},
+		{name: "quote in value", statement: "SELECT $1", args: []any{"it's"}, want: "SELECT 'it''s'"},
+		{name: "two digit placeholder", statement: "SELECT $10, $1", args: []any{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, want: "SELECT '10', '1'"},
+		{name: "placeholder without argument", statement: "SELECT $1, $2", args: []any{1}, want: "SELECT '1', $2"},
+		{name: "repeated placeholder", statement: "SELECT $1 + $1", args: []any{1}, want: "SELECT '1' + '1'"},
+		{name: "placeholder next to operator", statement: "SELECT a FROM t WHERE a=$1", args: []any{1}, want: "SELECT a FROM t WHERE a='1'"},
+
+		{name: "string literal", statement: "SELECT 'costs $1', $1", args: []any{5}, want: "SELECT 'costs $1', '5'"},
+		{name: "doubled quote in literal", statement: "SELECT 'it''s $1', $1", args: []any{5}, want: "SELECT 'it''s $1', '5'"},
+		{name: "escape string", statement: `SELECT E'\' $1', $1`, args: []any{5}, want: `SELECT E'\' $1', '5'`},
+		{name: "backslash in standard string", statement: `SELECT 'a\', $1`, args: []any{5}, want: `SELECT 'a\', '5'`},
+		{name: "quoted identifier", statement: `SELECT "col $1" FROM t WHERE a = $1`, args: []any{5}, want: `SELECT "col $1" FROM t WHERE a = '5'`},
+		{name: "dollar quoted body", statement: "SELECT $$ $1 $$, $1", args: []any{5}, want: "SELECT $$ $1 $$, '5'"},
+		{name: "tagged dollar quoted body", statement: "DO $body$ BEGIN PERFORM $1; END $body$; SELECT $1", args: []any{5}, want: "DO $body$ BEGIN PERFORM $1; END $body$; SELECT '5'"},
+		{name: "identifier with dollar", statement: "SELECT a$1 FROM t WHERE a = $1", args: []any{5}, want: "SELECT a$1 FROM t WHERE a = '5'"},
+		{name: "line comment", statement: "SELECT $1 -- uses $1\n, $1", args: []any{5}, want: "SELECT '5' -- uses $1\n, '5'"},
+		{name: "nested block comment", statement: "SELECT /* a /* $1 */ $1 */ $1", args: []any{5}, want: "SELECT /* a /* $1 */ $1 */ '5'"},
+		{name: "unterminated literal", statement: "SELECT $1, 'abc $1", args: []any{5}, want: "SELECT '5', 'abc $1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inlineArgs(tt.statement, tt.args); got != tt.want {
+				t.Errorf("inlineArgs(%q) = %q, want %q", tt.statement, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,387 @@
+package loadgen
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultClients  = 1
+	defaultDuration = 10 * time.Second
+
+	queryModeSimple   = "simple"
+	queryModeExtended = "extended"
+	queryModePrepared = "prepared"
+)
+
+var queryModes = []string{queryModeSimple, queryModeExtended, queryModePrepared}
+
+// Implementation is an in-process load generator running a transaction mix over database/sql.
+// It is used instead of pgbench when the native engine is configured.
+type Implementation struct {
+	db       *sql.DB
+	mix      Mix
+	clients  int
+	duration time.Duration
+	// variables and rate are workload defaults from the pgbench config
+	variables map[string]string
+	rate      float64
+	queryMode string
+}
+
+func New(db *sql.DB, pgbench config.Pgbench, cfg config.LoadGenerator) (*Implementation, error) {
+	mix, err := loadMix(cfg.Mix)
+	if err != nil {
+		return nil, fmt.Errorf("loadMix: %w", err)
+	}
+	if err := validateQueryMode(pgbench.QueryMode); err != nil {
+		return nil, fmt.Errorf("pgbench query_mode: %w", err)
+	}
+
+	clients := int(pgbench.NumOfClients)
+	if clients <= 0 {
+		clients = defaultClients
+	}
+	duration := time.Duration(pgbench.Duration) * time.Second
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+
+	return &Implementation{
+		db:        db,
+		mix:       mix,
+		clients:   clients,
+		duration:  duration,
+		variables: pgbench.Variables,
+		rate:      pgbench.Rate,
+		queryMode: pgbench.QueryMode,
+	}, nil
+}
+
+// InitializePgbench runs setup statements of the mix.
+func (i *Implementation) InitializePgbench(ctx context.Context) error {
+	for _, statement := range i.mix.Setup {
+		if _, err := i.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+	}
+	return nil
+}
+
+// RunPgbench runs the mix with the configured number of clients for the configured duration.
+// The workload may set the query mode, rate and variables, scripts are not supported.
+func (i *Implementation) RunPgbench(ctx context.Context, workload model.Workload) (model.ExternalMetric, error) {
+	if len(workload.Builtins) > 0 || len(workload.Scripts) > 0 {
+		return model.ExternalMetric{}, fmt.Errorf("%w: native load generator runs the configured transaction mix, scripts are not supported", model.ErrInvalidWorkload)
+	}
+	workload = model.Workload{Variables: i.variables, Rate: i.rate, QueryMode: i.queryMode}.Merge(workload)
+	if workload.Rate < 0 {
+		return model.ExternalMetric{}, fmt.Errorf("%w: rate should not be negative", model.ErrInvalidWorkload)
+	}
+	if err := validateQueryMode(workload.QueryMode); err != nil {
+		return model.ExternalMetric{}, err
+	}
+	if err := i.validateArgs(workload.Variables); err != nil {
+		return model.ExternalMetric{}, err
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, i.duration)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		results  = make([]clientResult, 0, i.clients)
+		throttle = newThrottle(workload.Rate)
+		firstErr error
+	)
+
+	start := time.Now()
+	for n := 0; n < i.clients; n++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+
+			result, err := i.runClient(runCtx, seed, workload, throttle)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			results = append(results, result)
+		}(time.Now().UnixNano() + int64(n))
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+	i.logFailures(results)
+
+	if err := ctx.Err(); err != nil {
+		return model.ExternalMetric{}, err
+	}
+	if firstErr != nil {
+		return model.ExternalMetric{}, firstErr
+	}
+	return summarize(results, elapsed), nil
+}
+
+// logFailures logs how many times each transaction failed over all clients with its first error.
+func (i *Implementation) logFailures(results []clientResult) {
+	for n, tx := range i.mix.Transactions {
+		var total failure
+		for _, result := range results {
+			f, ok := result.failures[n]
+			if !ok {
+				continue
+			}
+			if total.first == nil {
+				total.first = f.first
+			}
+			total.count += f.count
+		}
+		if total.count > 0 {
+			log.Printf("transaction %s failed %d times, first error: %v", tx.Name, total.count, total.first)
+		}
+	}
+}
+
+func validateQueryMode(queryMode string) error {
+	if queryMode != "" && !slices.Contains(queryModes, queryMode) {
+		return fmt.Errorf("%w: unknown query mode %q, expected one of %s", model.ErrInvalidWorkload, queryMode, strings.Join(queryModes, ", "))
+	}
+	return nil
+}
+
+func (i *Implementation) validateArgs(variables map[string]string) error {
+	for _, tx := range i.mix.Transactions {
+		for _, statement := range tx.Statements {
+			for _, arg := range statement.Args {
+				_, isParam := tx.Params[arg]
+				_, isVariable := variables[arg]
+				if !isParam && !isVariable {
+					return fmt.Errorf("%w: transaction %s: unknown argument %s", model.ErrInvalidWorkload, tx.Name, arg)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type clientResult struct {
+	transactions int64
+	errors       int64
+	latencies    []time.Duration
+	// failures count failures of each transaction of the mix, they are logged once at the end of the run
+	failures map[int]failure
+}
+
+type failure struct {
+	count int64
+	first error
+}
+
+// client executes transactions of the mix over its own session.
+type client struct {
+	conn       *sql.Conn
+	r          *rand.Rand
+	weights    []int
+	generators []map[string]func() any
+	// statements are prepared in the prepared query mode
+	statements [][]*sql.Stmt
+	// simple inlines arguments into statements, so they are sent with the simple query protocol
+	simple    bool
+	variables map[string]string
+}
+
+func (i *Implementation) runClient(ctx context.Context, seed int64, workload model.Workload, throttle *throttle) (clientResult, error) {
+	result := clientResult{failures: make(map[int]failure)}
+
+	conn, err := i.db.Conn(ctx)
+	if err != nil {
+		return result, fmt.Errorf("db.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	c := &client{
+		conn:      conn,
+		r:         rand.New(rand.NewSource(seed)),
+		simple:    workload.QueryMode == "" || workload.QueryMode == queryModeSimple,
+		variables: workload.Variables,
+	}
+	for _, tx := range i.mix.Transactions {
+		weight := tx.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		c.weights = append(c.weights, weight)
+
+		generators := make(map[string]func() any, len(tx.Params))
+		for name, param := range tx.Params {
+			generators[name] = param.generator(c.r)
+		}
+		c.generators = append(c.generators, generators)
+	}
+
+	if workload.QueryMode == queryModePrepared {
+		for _, tx := range i.mix.Transactions {
+			stmts := make([]*sql.Stmt, 0, len(tx.Statements))
+			for _, statement := range tx.Statements {
+				stmt, err := conn.PrepareContext(ctx, statement.SQL)
+				if err != nil {
+					return result, fmt.Errorf("transaction %s: conn.PrepareContext: %w", tx.Name, err)
+				}
+				defer stmt.Close()
+				stmts = append(stmts, stmt)
+			}
+			c.statements = append(c.statements, stmts)
+		}
+	}
+
+	for {
+		if err := throttle.wait(ctx); err != nil {
+			return result, nil
+		}
+
+		n := c.pick()
+		txStart := time.Now()
+		err := c.execute(ctx, i.mix.Transactions[n], n)
+		if ctx.Err() != nil {
+			// the run is over, the interrupted transaction is not counted
+			return result, nil
+		}
+		if err != nil {
+			result.errors++
+			f := result.failures[n]
+			if f.first == nil {
+				f.first = err
+			}
+			f.count++
+			result.failures[n] = f
+			continue
+		}
+		result.transactions++
+		result.latencies = append(result.latencies, time.Since(txStart))
+	}
+}
+
+func (c *client) pick() int {
+	total := 0
+	for _, weight := range c.weights {
+		total += weight
+	}
+
+	n := c.r.Intn(total)
+	for i, weight := range c.weights {
+		if n < weight {
+			return i
+		}
+		n -= weight
+	}
+	return len(c.weights) - 1
+}
+
+func (c *client) execute(ctx context.Context, tx Transaction, n int) error {
+	values := make(map[string]any, len(c.generators[n]))
+	for name, generate := range c.generators[n] {
+		values[name] = generate()
+	}
+	args := func(statement Statement) []any {
+		statementArgs := make([]any, 0, len(statement.Args))
+		for _, arg := range statement.Args {
+			if value, ok := values[arg]; ok {
+				statementArgs = append(statementArgs, value)
+				continue
+			}
+			statementArgs = append(statementArgs, c.variables[arg])
+		}
+		return statementArgs
+	}
+
+	sqlTx, err := c.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("conn.BeginTx: %w", err)
+	}
+	defer sqlTx.Rollback()
+
+	for s, statement := range tx.Statements {
+		switch {
+		case c.statements != nil:
+			_, err = sqlTx.StmtContext(ctx, c.statements[n][s]).ExecContext(ctx, args(statement)...)
+		case c.simple:
+			// the driver uses the simple query protocol for statements without arguments
+			_, err = sqlTx.ExecContext(ctx, inlineArgs(statement.SQL, args(statement)))
+		default:
+			_, err = sqlTx.ExecContext(ctx, statement.SQL, args(statement)...)
+		}
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", err)
+		}
+	}
+
+	if err = sqlTx.Commit(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+	return nil
+}
+
+func summarize(results []clientResult, elapsed time.Duration) model.ExternalMetric {
+	var (
+		completed, failed int64
+		latencies         []time.Duration
+	)
+	for _, result := range results {
+		completed += result.transactions
+		failed += result.errors
+		latencies = append(latencies, result.latencies...)
+	}
+	return model.SummarizeLoad(completed, failed, latencies, elapsed)
+}
+
+// throttle spaces transaction starts of all clients to reach the target rate, like pgbench -R.
+type throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newThrottle(rate float64) *throttle {
+	if rate <= 0 {
+		return &throttle{}
+	}
+	return &throttle{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+func (t *throttle) wait(ctx context.Context) error {
+	if t.interval == 0 {
+		return ctx.Err()
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	at := t.next
+	t.next = t.next.Add(t.interval)
+	t.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
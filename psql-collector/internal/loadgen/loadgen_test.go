@@ -0,0 +1,47 @@
+package loadgen
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestClientPick(t *testing.T) {
+	const draws = 100_000
+
+	tests := []struct {
+		name    string
+		weights []int
+	}{
+		{name: "single transaction", weights: []int{1}},
+		{name: "equal weights", weights: []int{1, 1}},
+		{name: "skewed weights", weights: []int{1, 3}},
+		{name: "many transactions", weights: []int{45, 45, 5, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &client{r: rand.New(rand.NewSource(1)), weights: tt.weights}
+
+			counts := make([]int, len(tt.weights))
+			for i := 0; i < draws; i++ {
+				n := c.pick()
+				if n < 0 || n >= len(tt.weights) {
+					t.Fatalf("pick() = %d, want an index of %d transactions", n, len(tt.weights))
+				}
+				counts[n]++
+			}
+
+			total := 0
+			for _, weight := range tt.weights {
+				total += weight
+			}
+			for n, weight := range tt.weights {
+				want := float64(weight) / float64(total)
+				if got := float64(counts[n]) / draws; math.Abs(got-want) > 0.01 {
+					t.Errorf("transaction %d picked %.3f of the time, want %.3f", n, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package loadgen
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"math"
+	"math/rand"
+	"os"
+)
+
+const (
+	paramUniform = "uniform"
+	paramZipf    = "zipf"
+	paramString  = "string"
+
+	stringAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// Mix is the set of transaction templates a load runs, read from YAML.
+type Mix struct {
+	// Setup statements are run once by InitLoad, e.g. to create and fill tables
+	Setup        []string      `yaml:"setup"`
+	Transactions []Transaction `yaml:"transactions"`
+}
+
+// Transaction is executed in one database transaction, parameters are generated once per execution.
+type Transaction struct {
+	Name string `yaml:"name"`
+	// Weight is the relative frequency of the transaction, 1 when not set
+	Weight     int              `yaml:"weight"`
+	Params     map[string]Param `yaml:"params"`
+	Statements []Statement      `yaml:"statements"`
+}
+
+type Statement struct {
+	SQL string `yaml:"sql"`
+	// Args are names of parameters or workload variables bound to $1, $2, ...
+	Args []string `yaml:"args"`
+}
+
+// Param generates a statement argument.
+type Param struct {
+	// Kind is uniform or zipf for integers in [Min, Max], or string for random alphanumeric strings
+	Kind string `yaml:"kind"`
+	Min  int64  `yaml:"min"`
+	Max  int64  `yaml:"max"`
+	// S is the zipf exponent, values close to 1 spread load and larger values make Min hot
+	S float64 `yaml:"s"`
+	// Length of random strings
+	Length int `yaml:"length"`
+}
+
+func loadMix(path string) (Mix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Mix{}, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	var mix Mix
+	if err = yaml.Unmarshal(data, &mix); err != nil {
+		return Mix{}, fmt.Errorf("yaml.Unmarshal: %w", err)
+	}
+	if err = mix.validate(); err != nil {
+		return Mix{}, fmt.Errorf("mix %s: %w", path, err)
+	}
+	return mix, nil
+}
+
+func (m Mix) validate() error {
+	if len(m.Transactions) == 0 {
+		return fmt.Errorf("transactions should be specified")
+	}
+
+	for _, tx := range m.Transactions {
+		if tx.Weight < 0 {
+			return fmt.Errorf("transaction %s: weight should not be negative", tx.Name)
+		}
+		if len(tx.Statements) == 0 {
+			return fmt.Errorf("transaction %s: statements should be specified", tx.Name)
+		}
+		for name, param := range tx.Params {
+			if err := param.validate(); err != nil {
+				return fmt.Errorf("transaction %s: param %s: %w", tx.Name, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p Param) validate() error {
+	switch p.Kind {
+	case paramUniform:
+		if p.Min > p.Max {
+			return fmt.Errorf("min should not be greater than max")
+		}
+		// the generator draws from max-min+1 values, it wraps to a non-positive number
+		// when the range holds more values than int64 can count
+		if p.Max-p.Min+1 <= 0 {
+			return fmt.Errorf("range from min to max should not hold more than %d values", int64(math.MaxInt64))
+		}
+	case paramZipf:
+		if p.Min > p.Max {
+			return fmt.Errorf("min should not be greater than max")
+		}
+		if p.S <= 1 {
+			return fmt.Errorf("s should be greater than 1")
+		}
+	case paramString:
+		if p.Length <= 0 {
+			return fmt.Errorf("length should be positive")
+		}
+	default:
+		return fmt.Errorf("unknown kind %q, expected %s, %s or %s", p.Kind, paramUniform, paramZipf, paramString)
+	}
+	return nil
+}
+
+// generator returns a function producing argument values, r must not be shared between goroutines.
+func (p Param) generator(r *rand.Rand) func() any {
+	switch p.Kind {
+	case paramZipf:
+		zipf := rand.NewZipf(r, p.S, 1, uint64(p.Max-p.Min))
+		return func() any {
+			return p.Min + int64(zipf.Uint64())
+		}
+	case paramString:
+		return func() any {
+			b := make([]byte, p.Length)
+			for i := range b {
+				b[i] = stringAlphabet[r.Intn(len(stringAlphabet))]
+			}
+			return string(b)
+		}
+	default:
+		return func() any {
+			return p.Min + r.Int63n(p.Max-p.Min+1)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package loadgen
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestParamValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   Param
+		wantErr bool
+	}{
+		{name: "uniform", param: Param{Kind: paramUniform, Min: 1, Max: 100}},
+		{name: "uniform single value", param: Param{Kind: paramUniform, Min: 5, Max: 5}},
+		{name: "uniform min greater than max", param: Param{Kind: paramUniform, Min: 10, Max: 1}, wantErr: true},
+		{name: "uniform widest range", param: Param{Kind: paramUniform, Min: 1, Max: math.MaxInt64}},
+		{name: "uniform widest negative range", param: Param{Kind: paramUniform, Min: math.MinInt64, Max: -2}},
+		{name: "uniform negative range one value too wide", param: Param{Kind: paramUniform, Min: math.MinInt64, Max: -1}, wantErr: true},
+		{name: "uniform range wider than int64", param: Param{Kind: paramUniform, Min: math.MinInt64, Max: math.MaxInt64}, wantErr: true},
+		{name: "uniform range one value too wide", param: Param{Kind: paramUniform, Min: 0, Max: math.MaxInt64}, wantErr: true},
+		{name: "zipf", param: Param{Kind: paramZipf, Min: 1, Max: 100, S: 1.1}},
+		{name: "zipf min greater than max", param: Param{Kind: paramZipf, Min: 10, Max: 1, S: 1.1}, wantErr: true},
+		{name: "zipf exponent of one", param: Param{Kind: paramZipf, Min: 1, Max: 100, S: 1}, wantErr: true},
+		{name: "string", param: Param{Kind: paramString, Length: 8}},
+		{name: "string without length", param: Param{Kind: paramString}, wantErr: true},
+		{name: "unknown kind", param: Param{Kind: "gaussian"}, wantErr: true},
+		{name: "no kind", param: Param{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.param.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParamGenerator(t *testing.T) {
+	const draws = 10_000
+
+	tests := []struct {
+		name  string
+		param Param
+		check func(value any) bool
+	}{
+		{
+			name:  "uniform",
+			param: Param{Kind: paramUniform, Min: -5, Max: 5},
+			check: inRange(-5, 5),
+		},
+		{
+			name:  "uniform widest range",
+			param: Param{Kind: paramUniform, Min: -1, Max: math.MaxInt64 - 2},
+			check: inRange(-1, math.MaxInt64-2),
+		},
+		{
+			name:  "uniform single value",
+			param: Param{Kind: paramUniform, Min: 7, Max: 7},
+			check: inRange(7, 7),
+		},
+		{
+			name:  "zipf",
+			param: Param{Kind: paramZipf, Min: 100, Max: 200, S: 1.5},
+			check: inRange(100, 200),
+		},
+		{
+			name:  "string",
+			param: Param{Kind: paramString, Length: 12},
+			check: func(value any) bool {
+				s, ok := value.(string)
+				return ok && len(s) == 12 && strings.Trim(s, stringAlphabet) == ""
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.param.validate(); err != nil {
+				t.Fatalf("validate() error = %v", err)
+			}
+
+			generate := tt.param.generator(rand.New(rand.NewSource(1)))
+			for i := 0; i < draws; i++ {
+				if value := generate(); !tt.check(value) {
+					t.Fatalf("generator() = %v, out of %+v", value, tt.param)
+				}
+			}
+		})
+	}
+}
+
+func inRange(min, max int64) func(value any) bool {
+	return func(value any) bool {
+		n, ok := value.(int64)
+		return ok && n >= min && n <= max
+	}
+}
@@ -0,0 +1,250 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+// maxFinishedJobs bounds the number of finished jobs kept in memory, the oldest ones are dropped first.
+const maxFinishedJobs = 100
+
+type Executor interface {
+	Start(ctx context.Context, action model.MaintenanceAction, relations []model.Relation) ([]model.MaintenanceJob, error)
+	Get(ctx context.Context, id string) (model.MaintenanceJob, error)
+	List(ctx context.Context) []model.MaintenanceJob
+	Cancel(ctx context.Context, id string) (model.MaintenanceJob, error)
+}
+
+type Collector interface {
+	PrepareMaintenance(ctx context.Context, action model.MaintenanceAction, schemaName, relationName string) (model.Relation, error)
+	RunMaintenance(ctx context.Context, action model.MaintenanceAction, relation model.Relation, started func(pid int64)) error
+	CollectMaintenanceProgress(ctx context.Context, pid int64) (model.MaintenanceProgress, error)
+	CollectRelation(ctx context.Context, schemaName, relationName string) (model.Relation, error)
+}
+
+type job struct {
+	model.MaintenanceJob
+	cancel context.CancelFunc
+}
+
+// Implementation runs VACUUM, ANALYZE and REINDEX as background jobs. Jobs outlive the request
+// that started them and are cancelled when ctx passed to New is done.
+type Implementation struct {
+	ctx     context.Context
+	collect Collector
+
+	mu     sync.Mutex
+	jobs   []*job
+	nextID int
+}
+
+func New(ctx context.Context, collect Collector) *Implementation {
+	return &Implementation{
+		ctx:     ctx,
+		collect: collect,
+	}
+}
+
+// Start validates all relations first and starts one job per relation only when all of them are valid.
+func (i *Implementation) Start(ctx context.Context, action model.MaintenanceAction, relations []model.Relation) ([]model.MaintenanceJob, error) {
+	prepared := make([]model.Relation, 0, len(relations))
+	for _, relation := range relations {
+		relation, err := i.collect.PrepareMaintenance(ctx, action, relation.SchemaName, relation.RelationName)
+		if err != nil {
+			return nil, fmt.Errorf("collect.PrepareMaintenance: %w", err)
+		}
+		if slices.ContainsFunc(prepared, func(r model.Relation) bool { return sameRelation(r, relation) }) {
+			return nil, fmt.Errorf("%w: relation %s.%s is specified more than once", model.ErrInvalidMaintenance, relation.SchemaName, relation.RelationName)
+		}
+		prepared = append(prepared, relation)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, relation := range prepared {
+		for _, j := range i.jobs {
+			if j.Status == model.MaintenanceJobRunning && sameRelation(model.Relation{SchemaName: j.SchemaName, RelationName: j.RelationName}, relation) {
+				return nil, fmt.Errorf("%w: job %s runs %s on %s.%s", model.ErrMaintenanceConflict, j.ID, j.Action, j.SchemaName, j.RelationName)
+			}
+		}
+	}
+
+	started := make([]model.MaintenanceJob, 0, len(prepared))
+	for _, relation := range prepared {
+		i.nextID++
+		jobCtx, cancel := context.WithCancel(i.ctx)
+		j := &job{
+			MaintenanceJob: model.MaintenanceJob{
+				ID:           strconv.Itoa(i.nextID),
+				Action:       action,
+				SchemaName:   relation.SchemaName,
+				RelationName: relation.RelationName,
+				Status:       model.MaintenanceJobRunning,
+				StartedAt:    time.Now(),
+				SizeBefore:   relation.SizeBytes,
+			},
+			cancel: cancel,
+		}
+		i.jobs = append(i.jobs, j)
+		started = append(started, j.MaintenanceJob)
+
+		go i.run(jobCtx, j, relation)
+	}
+	i.trim()
+
+	return started, nil
+}
+
+func (i *Implementation) run(ctx context.Context, j *job, relation model.Relation) {
+	defer j.cancel()
+
+	err := i.collect.RunMaintenance(ctx, j.Action, relation, func(pid int64) {
+		i.mu.Lock()
+		j.Pid = pid
+		i.mu.Unlock()
+	})
+
+	var sizeAfter sql.Null[float64]
+	if err == nil {
+		after, sizeErr := i.collect.CollectRelation(i.ctx, relation.SchemaName, relation.RelationName)
+		if sizeErr != nil {
+			// the size stays unknown rather than reporting the whole relation as reclaimed
+			log.Println(sizeErr)
+		} else {
+			sizeAfter = sql.Null[float64]{V: after.SizeBytes, Valid: true}
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	j.FinishedAt = time.Now()
+	j.Progress = model.MaintenanceProgress{}
+	switch {
+	case err == nil:
+		j.Status = model.MaintenanceJobSucceeded
+		j.SizeAfter = sizeAfter
+	// the driver may report the server error of the cancelled statement instead of the context error
+	case errors.Is(err, context.Canceled) || errors.Is(ctx.Err(), context.Canceled):
+		j.Status = model.MaintenanceJobCanceled
+		j.Error = err.Error()
+	default:
+		j.Status = model.MaintenanceJobFailed
+		j.Error = err.Error()
+	}
+}
+
+// Get returns the job with the current progress of running jobs.
+func (i *Implementation) Get(ctx context.Context, id string) (model.MaintenanceJob, error) {
+	i.mu.Lock()
+	j, err := i.find(id)
+	if err != nil {
+		i.mu.Unlock()
+		return model.MaintenanceJob{}, err
+	}
+	snapshot := j.MaintenanceJob
+	i.mu.Unlock()
+
+	if snapshot.Status != model.MaintenanceJobRunning || snapshot.Pid == 0 {
+		return snapshot, nil
+	}
+
+	progress, err := i.collect.CollectMaintenanceProgress(ctx, snapshot.Pid)
+	if err != nil {
+		return model.MaintenanceJob{}, fmt.Errorf("collect.CollectMaintenanceProgress: %w", err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if j.Status == model.MaintenanceJobRunning {
+		j.Progress = progress
+	}
+	return j.MaintenanceJob, nil
+}
+
+// List returns all kept jobs in the order they were started, progress is not refreshed.
+func (i *Implementation) List(_ context.Context) []model.MaintenanceJob {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	jobs := make([]model.MaintenanceJob, 0, len(i.jobs))
+	for _, j := range i.jobs {
+		jobs = append(jobs, j.MaintenanceJob)
+	}
+	return jobs
+}
+
+// Cancel cancels the statement of a running job and waits for the job to finish.
+// A cancelled REINDEX CONCURRENTLY may leave an invalid index with the _ccnew suffix behind.
+func (i *Implementation) Cancel(ctx context.Context, id string) (model.MaintenanceJob, error) {
+	i.mu.Lock()
+	j, err := i.find(id)
+	if err != nil {
+		i.mu.Unlock()
+		return model.MaintenanceJob{}, err
+	}
+	if j.Status != model.MaintenanceJobRunning {
+		i.mu.Unlock()
+		return model.MaintenanceJob{}, fmt.Errorf("%w: job %s", model.ErrJobFinished, id)
+	}
+	j.cancel()
+	i.mu.Unlock()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		i.mu.Lock()
+		if j.Status != model.MaintenanceJobRunning {
+			defer i.mu.Unlock()
+			return j.MaintenanceJob, nil
+		}
+		i.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return model.MaintenanceJob{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (i *Implementation) find(id string) (*job, error) {
+	for _, j := range i.jobs {
+		if j.ID == id {
+			return j, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", model.ErrJobNotFound, id)
+}
+
+// trim drops the oldest finished jobs above maxFinishedJobs.
+func (i *Implementation) trim() {
+	finished := 0
+	for _, j := range i.jobs {
+		if j.Status != model.MaintenanceJobRunning {
+			finished++
+		}
+	}
+
+	i.jobs = slices.DeleteFunc(i.jobs, func(j *job) bool {
+		if finished <= maxFinishedJobs || j.Status == model.MaintenanceJobRunning {
+			return false
+		}
+		finished--
+		return true
+	})
+}
+
+func sameRelation(a, b model.Relation) bool {
+	return a.SchemaName == b.SchemaName && a.RelationName == b.RelationName
+}
@@ -0,0 +1,28 @@
+package model
+
+// AutovacuumStat aggregates autovacuum state of the database for the tuning state.
+type AutovacuumStat struct {
+	// AutovacuumEnabled is 1 when both autovacuum and track_counts are on
+	AutovacuumEnabled float64
+
+	TablesNeedingVacuum           float64
+	TablesNeedingAnalyze          float64
+	TablesWithAutovacuumDisabled  float64
+	TablesWithAutovacuumOverrides float64
+
+	DeadRowsRatio    float64
+	MaxDeadRowsRatio float64
+	MaxXidAge        float64
+	// MaxXidAgeRatio is the largest age(relfrozenxid) relative to autovacuum_freeze_max_age in effect,
+	// anti-wraparound vacuum starts at 1
+	MaxXidAgeRatio  float64
+	MaxMultixactAge float64
+
+	LongRunningTransactions float64
+	// LongestTransactionTime is in milliseconds
+	LongestTransactionTime float64
+}
+
+func (t AutovacuumStat) IsMetric() bool {
+	return true
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Index is a pg_index entry of a user table.
+type Index struct {
+	ID           int64
+	SchemaName   string
+	TableName    string
+	IndexName    string
+	AccessMethod string
+	// Columns are table column numbers, 0 stands for an expression
+	Columns []int
+	// OperatorClasses are oids of operator classes of the columns
+	OperatorClasses []string
+	// Collations are oids of collations of the key columns, 0 for non-collatable types
+	Collations []string
+	// Options are per-column flags of the key columns such as DESC and NULLS FIRST
+	Options     []string
+	Expressions string
+	Predicate   string
+
+	Unique  bool
+	Primary bool
+	Valid   bool
+	// Constraint is true when a constraint depends on the index, e.g. a primary key or a foreign key
+	// referencing it, such an index can not be dropped on its own
+	Constraint bool
+
+	Scans      int64
+	SizeBytes  float64
+	Definition string
+}
+
+type IndexRecommendationKind int
+
+const (
+	IndexRecommendationUnspecified IndexRecommendationKind = iota
+	IndexUnused
+	IndexDuplicate
+	IndexOverlapping
+	IndexInvalid
+	IndexBloated
+)
+
+// IndexRecommendation proposes dropping or rebuilding an index. RelatedIndex is the index
+// that covers a duplicate or overlapping one, SavingsBytes is the estimated space freed.
+type IndexRecommendation struct {
+	Kind         IndexRecommendationKind
+	SchemaName   string
+	TableName    string
+	IndexName    string
+	RelatedIndex string
+	Reason       string
+	SavingsBytes float64
+	Statement    string
+}
+
+// IndexAdvice lists index recommendations. Usage statistics are collected since StatsReset,
+// it is not valid when statistics of the database were never reset.
+type IndexAdvice struct {
+	StatsReset      sql.Null[time.Time]
+	Recommendations []IndexRecommendation
+}
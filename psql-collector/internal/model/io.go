@@ -0,0 +1,132 @@
+package model
+
+// WalStat is pg_stat_wal, times are in milliseconds.
+type WalStat struct {
+	WalRecords     float64
+	WalFpi         float64
+	WalBytes       float64
+	WalBuffersFull float64
+	WalWrite       float64
+	WalSync        float64
+	WalWriteTime   float64
+	WalSyncTime    float64
+}
+
+func (t WalStat) IsMetric() bool {
+	return true
+}
+
+func (t WalStat) IsCounter() bool {
+	return true
+}
+
+// IOStat is a pg_stat_io row of a backend type, object and context, times are in milliseconds.
+type IOStat struct {
+	BackendType string
+	Object      string
+	Context     string
+	Reads       float64
+	ReadTime    float64
+	Writes      float64
+	WriteTime   float64
+	Writebacks  float64
+	Extends     float64
+	Fsyncs      float64
+	FsyncTime   float64
+	Hits        float64
+	Evictions   float64
+}
+
+func (t IOStat) IsMetric() bool {
+	return true
+}
+
+func (t IOStat) IsCounter() bool {
+	return true
+}
+
+// Backend types and contexts of pg_stat_io.
+const (
+	BackendTypeClient       = "client backend"
+	BackendTypeCheckpointer = "checkpointer"
+	BackendTypeBgwriter     = "background writer"
+	BackendTypeAutovacuum   = "autovacuum worker"
+
+	IOContextBulkRead  = "bulkread"
+	IOContextBulkWrite = "bulkwrite"
+	IOContextVacuum    = "vacuum"
+)
+
+// IOAggregateStat sums pg_stat_io operations by the backend types and contexts
+// that bgwriter, checkpoint and vacuum knobs affect.
+type IOAggregateStat struct {
+	ClientReads   float64
+	ClientWrites  float64
+	ClientExtends float64
+	ClientFsyncs  float64
+	ClientHits    float64
+
+	CheckpointerWrites float64
+	CheckpointerFsyncs float64
+	BgwriterWrites     float64
+	AutovacuumReads    float64
+	AutovacuumWrites   float64
+
+	BulkReadReads   float64
+	BulkWriteWrites float64
+	VacuumReads     float64
+	VacuumWrites    float64
+
+	IOReadTime  float64
+	IOWriteTime float64
+	IOFsyncTime float64
+	IOEvictions float64
+}
+
+func (t IOAggregateStat) IsMetric() bool {
+	return true
+}
+
+func (t IOAggregateStat) IsCounter() bool {
+	return true
+}
+
+func AggregateIOStats(stats []IOStat) IOAggregateStat {
+	var aggr IOAggregateStat
+
+	for _, stat := range stats {
+		switch stat.BackendType {
+		case BackendTypeClient:
+			aggr.ClientReads += stat.Reads
+			aggr.ClientWrites += stat.Writes
+			aggr.ClientExtends += stat.Extends
+			aggr.ClientFsyncs += stat.Fsyncs
+			aggr.ClientHits += stat.Hits
+		case BackendTypeCheckpointer:
+			aggr.CheckpointerWrites += stat.Writes
+			aggr.CheckpointerFsyncs += stat.Fsyncs
+		case BackendTypeBgwriter:
+			aggr.BgwriterWrites += stat.Writes
+		case BackendTypeAutovacuum:
+			aggr.AutovacuumReads += stat.Reads
+			aggr.AutovacuumWrites += stat.Writes
+		}
+
+		switch stat.Context {
+		case IOContextBulkRead:
+			aggr.BulkReadReads += stat.Reads
+		case IOContextBulkWrite:
+			aggr.BulkWriteWrites += stat.Writes
+		case IOContextVacuum:
+			aggr.VacuumReads += stat.Reads
+			aggr.VacuumWrites += stat.Writes
+		}
+
+		aggr.IOReadTime += stat.ReadTime
+		aggr.IOWriteTime += stat.WriteTime
+		aggr.IOFsyncTime += stat.FsyncTime
+		aggr.IOEvictions += stat.Evictions
+	}
+
+	return aggr
+}
@@ -0,0 +1,59 @@
+package model
+
+// LockWait is a backend waiting for a lock held or requested before it by another backend.
+// A backend blocked by several backends produces one LockWait per blocker.
+type LockWait struct {
+	BlockedPid   int64
+	BlockedUser  string
+	BlockedQuery string
+	BlockedState string
+	// Lock the blocked backend waits for
+	LockMode string
+	LockType string
+	Relation string
+	// WaitTime is in milliseconds
+	WaitTime float64
+
+	BlockingPid   int64
+	BlockingUser  string
+	BlockingQuery string
+	BlockingState string
+}
+
+// BlockingNode is a backend in the blocking tree. Roots hold locks without waiting themselves,
+// lock fields and WaitTime describe the lock the node waits for and are empty for roots.
+type BlockingNode struct {
+	Pid      int64
+	User     string
+	Query    string
+	State    string
+	LockMode string
+	LockType string
+	Relation string
+	WaitTime float64
+	Blocked  []BlockingNode
+}
+
+// LockWaitStat aggregates lock waits for the tuning state.
+type LockWaitStat struct {
+	WaitingBackends float64
+	// MaxLockWaitTime is in milliseconds
+	MaxLockWaitTime float64
+}
+
+func (t LockWaitStat) IsMetric() bool {
+	return true
+}
+
+func AggregateLockWaits(waits []LockWait) LockWaitStat {
+	var stat LockWaitStat
+
+	waiting := make(map[int64]struct{})
+	for _, wait := range waits {
+		waiting[wait.BlockedPid] = struct{}{}
+		stat.MaxLockWaitTime = max(stat.MaxLockWaitTime, wait.WaitTime)
+	}
+	stat.WaitingBackends = float64(len(waiting))
+
+	return stat
+}
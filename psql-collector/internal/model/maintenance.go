@@ -0,0 +1,108 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type MaintenanceAction int
+
+const (
+	MaintenanceActionUnspecified MaintenanceAction = iota
+	// MaintenanceVacuum runs VACUUM (ANALYZE)
+	MaintenanceVacuum
+	MaintenanceAnalyze
+	// MaintenanceReindex runs REINDEX CONCURRENTLY on a table or an index
+	MaintenanceReindex
+)
+
+func (a MaintenanceAction) String() string {
+	switch a {
+	case MaintenanceVacuum:
+		return "VACUUM (ANALYZE)"
+	case MaintenanceAnalyze:
+		return "ANALYZE"
+	case MaintenanceReindex:
+		return "REINDEX CONCURRENTLY"
+	default:
+		return "unspecified"
+	}
+}
+
+type MaintenanceJobStatus int
+
+const (
+	MaintenanceJobStatusUnspecified MaintenanceJobStatus = iota
+	MaintenanceJobRunning
+	MaintenanceJobSucceeded
+	MaintenanceJobFailed
+	MaintenanceJobCanceled
+)
+
+// Relation kinds of pg_class.relkind maintenance actions accept.
+const (
+	RelKindTable            = "r"
+	RelKindMaterializedView = "m"
+	RelKindToastTable       = "t"
+	RelKindPartitionedTable = "p"
+	RelKindIndex            = "i"
+)
+
+// Relation is a table or an index with its total size including indexes and TOAST.
+type Relation struct {
+	SchemaName   string
+	RelationName string
+	Kind         string
+	SizeBytes    float64
+}
+
+// QualifiedName renders schema.name with both identifiers quoted, for statements and regclass lookups.
+func QualifiedName(schemaName, relationName string) string {
+	return pq.QuoteIdentifier(schemaName) + "." + pq.QuoteIdentifier(relationName)
+}
+
+// MaintenanceProgress is a row of pg_stat_progress_vacuum, pg_stat_progress_analyze
+// or pg_stat_progress_create_index of the backend running a job.
+type MaintenanceProgress struct {
+	Phase       string
+	BlocksTotal float64
+	BlocksDone  float64
+}
+
+type MaintenanceJob struct {
+	ID           string
+	Action       MaintenanceAction
+	SchemaName   string
+	RelationName string
+	Status       MaintenanceJobStatus
+	// Pid is the backend running the statement
+	Pid        int64
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Progress is reported while the job is running
+	Progress MaintenanceProgress
+
+	SizeBefore float64
+	// SizeAfter is not valid until the job succeeds, or when the size could not be read afterwards
+	SizeAfter sql.Null[float64]
+	Error     string
+}
+
+// Duration is the running time so far for running jobs.
+func (j MaintenanceJob) Duration() time.Duration {
+	if j.FinishedAt.IsZero() {
+		return time.Since(j.StartedAt)
+	}
+	return j.FinishedAt.Sub(j.StartedAt)
+}
+
+// ReclaimedBytes is the decrease of the relation size, it is known for succeeded jobs
+// whose size was read after they finished.
+func (j MaintenanceJob) ReclaimedBytes() sql.Null[float64] {
+	if j.Status != MaintenanceJobSucceeded || !j.SizeAfter.Valid {
+		return sql.Null[float64]{}
+	}
+	return sql.Null[float64]{V: j.SizeBefore - j.SizeAfter.V, Valid: true}
+}
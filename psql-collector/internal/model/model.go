@@ -2,28 +2,265 @@ package model
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"reflect"
+	"slices"
 	"time"
 )
 
+var (
+	// ErrInvalidKnob is returned when a knob can not be set: it is unknown, read-only or has an invalid value.
+	ErrInvalidKnob = errors.New("invalid knob")
+	// ErrKnobForbidden is returned when the knob policy does not allow changing a knob.
+	ErrKnobForbidden = errors.New("knob is forbidden by policy")
+	// ErrInvalidTableOption is returned when a storage parameter can not be set: the relation or
+	// the parameter is unknown, or the value is out of range.
+	ErrInvalidTableOption = errors.New("invalid table option")
+	// ErrTableOptionForbidden is returned when the table option policy does not allow changing a storage parameter.
+	ErrTableOptionForbidden = errors.New("table option is forbidden by policy")
+
+	// ErrNotSupported is returned for statistics the PostgreSQL server version does not provide.
+	ErrNotSupported = errors.New("not supported")
+
+	ErrSnapshotNotFound      = errors.New("snapshot not found")
+	ErrSnapshotAlreadyExists = errors.New("snapshot already exists")
+
+	// ErrInvalidMaintenance is returned when a maintenance action can not run on a relation.
+	ErrInvalidMaintenance = errors.New("invalid maintenance")
+	// ErrMaintenanceConflict is returned when a job is already running on the relation.
+	ErrMaintenanceConflict = errors.New("maintenance is already running on the relation")
+	ErrJobNotFound         = errors.New("maintenance job not found")
+	ErrJobFinished         = errors.New("maintenance job is already finished")
+
+	// ErrInvalidWorkload is returned when pgbench scripts or options of a load are invalid.
+	ErrInvalidWorkload = errors.New("invalid workload")
+
+	// ErrMetricGroupNotSampled is returned when subscribing to a metric group the background runner does not sample.
+	ErrMetricGroupNotSampled = errors.New("metric group is not sampled")
+)
+
+const (
+	VarTypeBool    = "bool"
+	VarTypeInteger = "integer"
+	VarTypeReal    = "real"
+	VarTypeString  = "string"
+	VarTypeEnum    = "enum"
+)
+
+const (
+	ContextPostmaster = "postmaster"
+	ContextInternal   = "internal"
+)
+
+// fileLocationKnobs point the server at its data directory and configuration files,
+// a wrong value keeps PostgreSQL from starting.
+var fileLocationKnobs = []string{
+	"data_directory",
+	"config_file",
+	"hba_file",
+	"ident_file",
+	"external_pid_file",
+}
+
+// Knob is a PostgreSQL setting. Value holds float64 for integer and real knobs,
+// bool for bool knobs and string for string and enum knobs.
+// Numeric values and bounds are normalized to Unit, which is UnitBytes, UnitMilliseconds or empty.
 type Knob struct {
-	Name   string
-	Value  interface{}
-	MinVal interface{}
-	MaxVal interface{}
+	Name     string
+	VarType  string
+	Unit     string
+	Value    interface{}
+	MinVal   interface{}
+	MaxVal   interface{}
+	EnumVals []string
+
+	// Context is pg_settings.context, "postmaster" knobs require a server restart
+	Context        string
+	PendingRestart bool
+	// Source is pg_settings.source, e.g. "default", "configuration file" or "command line"
+	Source string
+}
+
+// IsTunable reports whether the knob can be changed with ALTER SYSTEM.
+func (k Knob) IsTunable() bool {
+	return k.Context != ContextInternal && !slices.Contains(fileLocationKnobs, k.Name)
+}
+
+// KnobSnapshot is a named copy of all tunable knobs.
+type KnobSnapshot struct {
+	Name      string
+	CreatedAt time.Time
+	Knobs     []Knob
+	// AutoConf is the content of postgresql.auto.conf when the snapshot was taken,
+	// restoring the snapshot brings the file back to it
+	AutoConf []AutoConfSetting
+}
+
+// AutoConfSetting is a postgresql.auto.conf entry written by ALTER SYSTEM, Setting is the value as written.
+type AutoConfSetting struct {
+	Name    string
+	Setting string
+}
+
+type KnobApplyStatus int
+
+const (
+	KnobApplyStatusUnspecified KnobApplyStatus = iota
+	KnobApplied
+	KnobPendingRestart
+	KnobRejected
+)
+
+// KnobApplyResult is the outcome of applying a single knob after configuration reload.
+type KnobApplyResult struct {
+	Name           string
+	RequestedValue interface{}
+	EffectiveValue interface{}
+	Status         KnobApplyStatus
+	Error          string
 }
 
 type ExternalMetric struct {
-	Tps     float64
+	Tps float64
+	// Latency is the average transaction latency in milliseconds
 	Latency float64
+	// LatencyP50, LatencyP95 and LatencyP99 are latency percentiles in milliseconds,
+	// they are reported by the native load generator only
+	LatencyP50 float64
+	LatencyP95 float64
+	LatencyP99 float64
+	// Transactions is the number of completed transactions
+	Transactions int64
+	// Errors is the number of failed transactions
+	Errors int64
 }
 
 type InternalMetric struct {
 	Name  string
 	Value interface{}
 	Scope Scope
+	// Counter marks cumulative counters since stats reset
+	Counter bool
+	// CounterReset is set when the counter went backwards between samples
+	CounterReset bool
+	// Labels identify the row of a per-table or per-index metric, keyed by field name
+	Labels map[string]string
+}
+
+// MetricsMode selects how counter metrics are reported.
+type MetricsMode int
+
+const (
+	// MetricsModeRaw returns counters as they are
+	MetricsModeRaw MetricsMode = iota
+	// MetricsModeDelta returns counter increase over the interval
+	MetricsModeDelta
+	// MetricsModeRate returns counter increase per second over the interval
+	MetricsModeRate
+)
+
+// MetricGroup is a subset of aggregated metrics collected together.
+type MetricGroup int
+
+const (
+	MetricGroupUnspecified MetricGroup = iota
+	// MetricGroupActivity is database, WAL and IO counters, query types and shared buffer hit rate
+	MetricGroupActivity
+	// MetricGroupLocks is lock waits and sampled wait events
+	MetricGroupLocks
+	MetricGroupReplication
+	MetricGroupAutovacuum
+	// MetricGroupBloat is table and index bloat estimates, the most expensive group to collect
+	MetricGroupBloat
+	// MetricGroupTables is per-table statistics aggregated over tables
+	MetricGroupTables
+)
+
+// metricGroupNames are names of groups in config/config.yaml.
+var metricGroupNames = map[string]MetricGroup{
+	"activity":    MetricGroupActivity,
+	"locks":       MetricGroupLocks,
+	"replication": MetricGroupReplication,
+	"autovacuum":  MetricGroupAutovacuum,
+	"bloat":       MetricGroupBloat,
+	"tables":      MetricGroupTables,
+}
+
+// ParseMetricGroup resolves a group name such as "bloat".
+func ParseMetricGroup(name string) (MetricGroup, error) {
+	group, ok := metricGroupNames[name]
+	if !ok {
+		return MetricGroupUnspecified, fmt.Errorf("unknown metric group %q", name)
+	}
+	return group, nil
+}
+
+func (g MetricGroup) String() string {
+	for name, group := range metricGroupNames {
+		if group == g {
+			return name
+		}
+	}
+	return "unspecified"
+}
+
+// MetricGroups lists all groups in the order their metrics are reported.
+var MetricGroups = []MetricGroup{
+	MetricGroupActivity,
+	MetricGroupLocks,
+	MetricGroupReplication,
+	MetricGroupAutovacuum,
+	MetricGroupBloat,
+	MetricGroupTables,
+}
+
+// MetricSnapshot is aggregated metrics delivered to a subscriber.
+type MetricSnapshot struct {
+	TakenAt time.Time
+	Metrics []InternalMetric
+	// Interval covered by counter deltas, the longest one when groups were sampled at different times, zero in raw mode
+	Interval time.Duration
+	// Err is set instead of metrics when a subscribed group failed to be sampled
+	Err error
+}
+
+// StatementStat is a pg_stat_statements entry aggregated per queryid, times are in milliseconds.
+type StatementStat struct {
+	QueryID        int64
+	Query          string
+	Calls          float64
+	TotalExecTime  float64
+	MeanExecTime   float64
+	StddevExecTime float64
+	Rows           float64
+
+	SharedBlksHit     float64
+	SharedBlksRead    float64
+	SharedBlksDirtied float64
+	SharedBlksWritten float64
+	LocalBlksHit      float64
+	LocalBlksRead     float64
+	LocalBlksDirtied  float64
+	LocalBlksWritten  float64
+	TempBlksRead      float64
+	TempBlksWritten   float64
+	WalBytes          float64
 }
 
+// StatementsOrder is the column top statements are sorted by in descending order.
+type StatementsOrder int
+
+const (
+	OrderByTotalExecTime StatementsOrder = iota
+	OrderByCalls
+	OrderByMeanExecTime
+	OrderByRows
+	OrderBySharedBlksRead
+	OrderByTempBlksWritten
+	OrderByWalBytes
+)
+
 type Scope int
 
 const (
@@ -58,18 +295,51 @@ type Metric interface {
 	IsMetric() bool
 }
 
+// Counter is implemented by metrics whose fields are cumulative counters since stats reset.
+// Fields tagged `metric:"gauge"` are not counters.
+type Counter interface {
+	IsCounter() bool
+}
+
+// QueryTypesDistribution is the workload mix of pg_stat_statements weighted by executions
+// and by execution time. Read statements are selects and COPY TO, write statements are
+// inserts, updates, deletes and COPY FROM.
 type QueryTypesDistribution struct {
-	Insert int64
-	Update int64
-	Delete int64
-	Select int64
-	Other  int64
+	// Number of executions per statement type
+	Insert  int64
+	Update  int64
+	Delete  int64
+	Select  int64
+	Copy    int64
+	DDL     int64
+	Utility int64
+	Other   int64
+
+	// Total execution time per statement type in milliseconds
+	InsertTime  float64
+	UpdateTime  float64
+	DeleteTime  float64
+	SelectTime  float64
+	CopyTime    float64
+	DDLTime     float64
+	UtilityTime float64
+	OtherTime   float64
+
+	// Shares of read and write statements among read and write executions and their time
+	ReadCallsRatio  float64 `metric:"gauge"`
+	WriteCallsRatio float64 `metric:"gauge"`
+	ReadTimeRatio   float64 `metric:"gauge"`
+	WriteTimeRatio  float64 `metric:"gauge"`
 }
 
 func (t QueryTypesDistribution) IsMetric() bool {
 	return true
 }
 
+func (t QueryTypesDistribution) IsCounter() bool {
+	return true
+}
+
 type SharedBufferHitRate struct {
 	HitRate float64
 }
@@ -80,7 +350,7 @@ func (t SharedBufferHitRate) IsMetric() bool {
 
 // TableStat brief information about table
 type TableStat struct {
-	RelationID   int64
+	RelationID   int64 `metric:"label"`
 	RelationName string
 
 	NumberOfLiveTuples int64
@@ -200,12 +470,25 @@ func (t WalWriteAndFlushStat) IsMetric() bool {
 	return true
 }
 
+func (t WalWriteAndFlushStat) IsCounter() bool {
+	return true
+}
+
+// Bloat kinds label table and index bloat metrics.
+const (
+	BloatKindTable = "table"
+	BloatKindIndex = "index"
+)
+
 type TableBloating struct {
-	TableName        string
-	NumOfRows        float64
-	BloatInPercent   float64
-	BloatInMegabytes float64
-	TableSize        float64
+	RelationID            int64 `metric:"label"`
+	Kind                  string
+	SchemaName            string
+	TableName             string
+	NumOfRows             float64
+	TableBloatInPercent   float64
+	TableBloatInMegabytes float64
+	TableSize             float64
 }
 
 func (t TableBloating) IsMetric() bool {
@@ -213,13 +496,16 @@ func (t TableBloating) IsMetric() bool {
 }
 
 type IndexBloating struct {
-	TableName        string
-	IndexName        string
-	BloatInPercent   float64
-	BloatInMegabytes float64
-	IndexSize        float64
-	TableSize        float64
-	NumOfIndexScans  float64
+	IndexID               int64 `metric:"label"`
+	Kind                  string
+	SchemaName            string
+	TableName             string
+	IndexName             string
+	IndexBloatInPercent   float64
+	IndexBloatInMegabytes float64
+	IndexSize             float64
+	IndexedTableSize      float64
+	NumOfIndexScans       float64
 }
 
 func (t IndexBloating) IsMetric() bool {
@@ -250,21 +536,81 @@ func (t DatabaseStat) IsMetric() bool {
 	return true
 }
 
+func (t DatabaseStat) IsCounter() bool {
+	return true
+}
+
+// ToInternalMetric converts every field of metric to an internal metric, except fields tagged
+// `metric:"label"` which identify the row rather than measure it.
 func ToInternalMetric[T Metric](metric T, scope Scope) []InternalMetric {
 	var internalMetrics []InternalMetric
 
+	_, counter := any(metric).(Counter)
+
 	val := reflect.ValueOf(metric)
 	t := val.Type()
 
 	for i := 0; i < val.NumField(); i++ {
 		valueField := val.Field(i)
 		typeField := t.Field(i)
+		if typeField.Tag.Get("metric") == "label" {
+			continue
+		}
 
 		internalMetrics = append(internalMetrics, InternalMetric{
-			Name:  typeField.Name,
-			Value: valueField.Interface(),
-			Scope: scope,
+			Name:    typeField.Name,
+			Value:   valueField.Interface(),
+			Scope:   scope,
+			Counter: counter && typeField.Tag.Get("metric") != "gauge",
 		})
 	}
 	return internalMetrics
 }
+
+// ToLabeledInternalMetric is like ToInternalMetric for per-row metrics: string fields and
+// fields tagged `metric:"label"` become labels of the remaining fields instead of metrics.
+func ToLabeledInternalMetric[T Metric](metric T, scope Scope) []InternalMetric {
+	_, counter := any(metric).(Counter)
+
+	val := reflect.ValueOf(metric)
+	t := val.Type()
+
+	labels := make(map[string]string)
+	for i := 0; i < val.NumField(); i++ {
+		if isLabelField(t.Field(i)) {
+			labels[t.Field(i).Name] = fmt.Sprint(val.Field(i).Interface())
+		}
+	}
+
+	var internalMetrics []InternalMetric
+	for i := 0; i < val.NumField(); i++ {
+		typeField := t.Field(i)
+		if isLabelField(typeField) {
+			continue
+		}
+
+		internalMetrics = append(internalMetrics, InternalMetric{
+			Name:    typeField.Name,
+			Value:   val.Field(i).Interface(),
+			Scope:   scope,
+			Counter: counter && typeField.Tag.Get("metric") != "gauge",
+			Labels:  labels,
+		})
+	}
+	return internalMetrics
+}
+
+func isLabelField(field reflect.StructField) bool {
+	return field.Type.Kind() == reflect.String || field.Tag.Get("metric") == "label"
+}
+
+// MetricSample is the set of aggregated metrics collected at once, counters hold raw values.
+type MetricSample struct {
+	TakenAt time.Time
+	Metrics []InternalMetric
+}
+
+type KnobSample struct {
+	TakenAt time.Time
+	Knobs   []Knob
+}
@@ -0,0 +1,35 @@
+package model
+
+import "testing"
+
+func TestToInternalMetricSkipsLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		metrics []InternalMetric
+		label   string
+	}{
+		{
+			name:    "table bloat",
+			metrics: ToInternalMetric(TableBloating{RelationID: 16384, TableName: "t", TableBloatInPercent: 12}, General),
+			label:   "RelationID",
+		},
+		{
+			name:    "index bloat",
+			metrics: ToInternalMetric(IndexBloating{IndexID: 16390, IndexName: "t_pkey", IndexBloatInPercent: 30}, General),
+			label:   "IndexID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.metrics) == 0 {
+				t.Fatal("ToInternalMetric() returned no metrics")
+			}
+			for _, metric := range tt.metrics {
+				if metric.Name == tt.label {
+					t.Errorf("ToInternalMetric() reported label %s as metric %v", tt.label, metric.Value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package model
+
+// Replica is a pg_stat_replication row of a WAL sender. Lags are in milliseconds,
+// ReplayLagBytes is the WAL the replica has not replayed yet.
+type Replica struct {
+	Pid             int64
+	ApplicationName string
+	ClientAddr      string
+	State           string
+	SyncState       string
+	WriteLag        float64
+	FlushLag        float64
+	ReplayLag       float64
+	ReplayLagBytes  float64
+}
+
+// ReplicationSlot is a pg_replication_slots row. RetainedWalBytes is the WAL kept for the slot,
+// WalStatus is empty before PostgreSQL 13.
+type ReplicationSlot struct {
+	SlotName         string
+	SlotType         string
+	Database         string
+	Active           bool
+	RetainedWalBytes float64
+	WalStatus        string
+}
+
+// ReplicationState is the role of the server with its replicas and slots.
+type ReplicationState struct {
+	InRecovery bool
+	Replicas   []Replica
+	Slots      []ReplicationSlot
+}
+
+// ReplicationStat aggregates ReplicationState for the tuning state.
+type ReplicationStat struct {
+	// InRecovery is 1 on standbys
+	InRecovery   float64
+	Replicas     float64
+	SyncReplicas float64
+	// Lags are in milliseconds
+	MaxWriteLag       float64
+	MaxFlushLag       float64
+	MaxReplayLag      float64
+	MaxReplayLagBytes float64
+
+	ReplicationSlots         float64
+	InactiveReplicationSlots float64
+	SlotsRetainedWalBytes    float64
+	MaxSlotRetainedWalBytes  float64
+}
+
+func (t ReplicationStat) IsMetric() bool {
+	return true
+}
+
+func AggregateReplication(state ReplicationState) ReplicationStat {
+	var stat ReplicationStat
+
+	if state.InRecovery {
+		stat.InRecovery = 1
+	}
+
+	for _, replica := range state.Replicas {
+		stat.Replicas++
+		if replica.SyncState == "sync" || replica.SyncState == "quorum" {
+			stat.SyncReplicas++
+		}
+		stat.MaxWriteLag = max(stat.MaxWriteLag, replica.WriteLag)
+		stat.MaxFlushLag = max(stat.MaxFlushLag, replica.FlushLag)
+		stat.MaxReplayLag = max(stat.MaxReplayLag, replica.ReplayLag)
+		stat.MaxReplayLagBytes = max(stat.MaxReplayLagBytes, replica.ReplayLagBytes)
+	}
+
+	for _, slot := range state.Slots {
+		stat.ReplicationSlots++
+		if !slot.Active {
+			stat.InactiveReplicationSlots++
+		}
+		stat.SlotsRetainedWalBytes += slot.RetainedWalBytes
+		stat.MaxSlotRetainedWalBytes = max(stat.MaxSlotRetainedWalBytes, slot.RetainedWalBytes)
+	}
+
+	return stat
+}
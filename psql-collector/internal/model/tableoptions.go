@@ -0,0 +1,41 @@
+package model
+
+// TableOption is a storage parameter of a table, e.g. fillfactor or autovacuum_vacuum_scale_factor.
+type TableOption struct {
+	Name  string
+	Value string
+}
+
+// TableOptions are storage parameters to set on a table with ALTER TABLE ... SET.
+type TableOptions struct {
+	SchemaName   string
+	RelationName string
+	Options      []TableOption
+}
+
+// TableOptionRecommendation proposes a value of a storage parameter.
+// CurrentValue is the value in effect, taken from the database settings when the table does not override it.
+type TableOptionRecommendation struct {
+	Name         string
+	Value        string
+	CurrentValue string
+	Rationale    string
+}
+
+type TableOptionsRecommendation struct {
+	SchemaName   string
+	RelationName string
+	Options      []TableOptionRecommendation
+}
+
+// TableOptionApplyResult is the outcome of setting a single storage parameter,
+// EffectiveValue is read back from pg_class.reloptions.
+type TableOptionApplyResult struct {
+	SchemaName     string
+	RelationName   string
+	Name           string
+	RequestedValue string
+	EffectiveValue string
+	Status         KnobApplyStatus
+	Error          string
+}
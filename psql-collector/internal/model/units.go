@@ -0,0 +1,126 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Canonical units knob values are normalized to.
+const (
+	UnitBytes        = "B"
+	UnitMilliseconds = "ms"
+)
+
+var (
+	memoryUnits = map[string]float64{
+		"B":  1,
+		"kB": 1 << 10,
+		"MB": 1 << 20,
+		"GB": 1 << 30,
+		"TB": 1 << 40,
+	}
+	timeUnits = map[string]float64{
+		"us":  0.001,
+		"ms":  1,
+		"s":   1000,
+		"min": 60 * 1000,
+		"h":   60 * 60 * 1000,
+		"d":   24 * 60 * 60 * 1000,
+	}
+)
+
+// ParseUnit resolves pg_settings.unit (e.g. "8kB", "16MB", "s") into a multiplier
+// and the canonical unit. Knobs without a unit have multiplier 1 and empty canonical unit.
+func ParseUnit(unit string) (float64, string, error) {
+	if unit == "" {
+		return 1, "", nil
+	}
+
+	// pg_settings.unit may be prefixed with a block size, as in "8kB"
+	idx := strings.IndexFunc(unit, func(r rune) bool { return r < '0' || r > '9' })
+	if idx == -1 {
+		return 0, "", fmt.Errorf("unknown unit %q", unit)
+	}
+	multiplier := 1.
+	if idx > 0 {
+		n, err := strconv.ParseFloat(unit[:idx], 64)
+		if err != nil {
+			return 0, "", fmt.Errorf("strconv.ParseFloat: %w", err)
+		}
+		multiplier = n
+	}
+
+	if factor, ok := memoryUnits[unit[idx:]]; ok {
+		return multiplier * factor, UnitBytes, nil
+	}
+	if factor, ok := timeUnits[unit[idx:]]; ok {
+		return multiplier * factor, UnitMilliseconds, nil
+	}
+	return 0, "", fmt.Errorf("unknown unit %q", unit)
+}
+
+// ToCanonicalUnit converts value expressed in pg_settings.unit to bytes or milliseconds.
+func ToCanonicalUnit(value float64, unit string) (float64, string, error) {
+	multiplier, canonical, err := ParseUnit(unit)
+	if err != nil {
+		return 0, "", err
+	}
+	return value * multiplier, canonical, nil
+}
+
+// ParseHumanValue parses values such as "256MB", "5min" or "100" into canonical units.
+// The returned unit is empty when the value has no unit suffix.
+func ParseHumanValue(value string) (float64, string, error) {
+	value = strings.TrimSpace(value)
+	idx := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	if idx == -1 {
+		idx = len(value)
+	}
+
+	number, err := strconv.ParseFloat(value[:idx], 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("strconv.ParseFloat: %w", err)
+	}
+
+	unit := strings.TrimSpace(value[idx:])
+	if unit == "" {
+		return number, "", nil
+	}
+	if factor, ok := memoryUnits[unit]; ok {
+		return number * factor, UnitBytes, nil
+	}
+	if factor, ok := timeUnits[unit]; ok {
+		return number * factor, UnitMilliseconds, nil
+	}
+	return 0, "", fmt.Errorf("unknown unit %q in value %q", unit, value)
+}
+
+// ToCanonicalValue converts a numeric knob value to unit, the canonical unit of the knob.
+// Floats and strings without unit suffix are already canonical, as CollectKnobs reports them,
+// strings with a suffix such as "256MB" or "5min" are converted and must be of the same kind as unit.
+func ToCanonicalValue(value interface{}, unit string) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		number, valueUnit, err := ParseHumanValue(v)
+		if err != nil {
+			return 0, err
+		}
+		if valueUnit != "" && valueUnit != unit {
+			return 0, fmt.Errorf("value %q is not compatible with unit %q", v, unit)
+		}
+		return number, nil
+	default:
+		return 0, fmt.Errorf("value %v is not a number", value)
+	}
+}
+
+// FormatCanonicalValue renders a value in canonical unit with the unit suffix spelled out,
+// a bare number would be read by PostgreSQL in the knob's own unit, e.g. 8kB pages for shared_buffers.
+func FormatCanonicalValue(value float64, unit string) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + unit
+}
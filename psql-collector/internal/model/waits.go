@@ -0,0 +1,92 @@
+package model
+
+import "time"
+
+// Wait event types of pg_stat_activity reported as separate metrics.
+const (
+	WaitEventTypeCPU     = "CPU"
+	WaitEventTypeIO      = "IO"
+	WaitEventTypeLWLock  = "LWLock"
+	WaitEventTypeLock    = "Lock"
+	WaitEventTypeClient  = "Client"
+	WaitEventTypeIPC     = "IPC"
+	WaitEventTypeTimeout = "Timeout"
+)
+
+// SessionWait is the state of a non-idle client backend at sampling time. Backends that
+// are active and do not wait are reported with WaitEventTypeCPU.
+type SessionWait struct {
+	WaitEventType string
+	WaitEvent     string
+	State         string
+}
+
+type WaitEventSample struct {
+	TakenAt  time.Time
+	Sessions []SessionWait
+}
+
+type WaitEventCount struct {
+	WaitEventType string
+	WaitEvent     string
+	Count         int64
+}
+
+// WaitEventHistogram counts sampled sessions per wait event between From and To.
+type WaitEventHistogram struct {
+	From     time.Time
+	To       time.Time
+	Samples  int64
+	Sessions int64
+	Events   []WaitEventCount
+}
+
+// WaitEventStat is the share of sampled sessions per wait event type.
+type WaitEventStat struct {
+	WaitCPUFraction     float64
+	WaitIOFraction      float64
+	WaitLWLockFraction  float64
+	WaitLockFraction    float64
+	WaitClientFraction  float64
+	WaitIPCFraction     float64
+	WaitTimeoutFraction float64
+	WaitOtherFraction   float64
+	// AverageActiveSessions is the mean number of non-idle sessions per sample
+	AverageActiveSessions float64
+}
+
+func (t WaitEventStat) IsMetric() bool {
+	return true
+}
+
+func AggregateWaitEvents(histogram WaitEventHistogram) WaitEventStat {
+	var stat WaitEventStat
+	if histogram.Sessions == 0 {
+		return stat
+	}
+
+	for _, event := range histogram.Events {
+		fraction := float64(event.Count) / float64(histogram.Sessions)
+		switch event.WaitEventType {
+		case WaitEventTypeCPU:
+			stat.WaitCPUFraction += fraction
+		case WaitEventTypeIO:
+			stat.WaitIOFraction += fraction
+		case WaitEventTypeLWLock:
+			stat.WaitLWLockFraction += fraction
+		case WaitEventTypeLock:
+			stat.WaitLockFraction += fraction
+		case WaitEventTypeClient:
+			stat.WaitClientFraction += fraction
+		case WaitEventTypeIPC:
+			stat.WaitIPCFraction += fraction
+		case WaitEventTypeTimeout:
+			stat.WaitTimeoutFraction += fraction
+		default:
+			stat.WaitOtherFraction += fraction
+		}
+	}
+	stat.AverageActiveSessions = float64(histogram.Sessions) / float64(histogram.Samples)
+
+	return stat
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// Workload selects pgbench scripts and options of a load run.
+type Workload struct {
+	// Builtins are built-in pgbench scripts: tpcb-like, simple-update or select-only
+	Builtins []WorkloadScript
+	// Scripts are custom script files
+	Scripts []WorkloadScript
+	// QueryMode is simple, extended or prepared
+	QueryMode string
+	// Rate is the target transactions per second, zero means unlimited
+	Rate float64
+	// Variables are set in every script
+	Variables map[string]string
+}
+
+type WorkloadScript struct {
+	// Name is a built-in script name or a script file path
+	Name string
+	// Weight is the relative frequency of the script, zero means the default of 1
+	Weight int64
+}
+
+// Merge returns the workload with fields set in override replacing its own,
+// builtins and scripts are replaced together and variables are merged.
+func (w Workload) Merge(override Workload) Workload {
+	merged := w
+	if len(override.Builtins) > 0 || len(override.Scripts) > 0 {
+		merged.Builtins, merged.Scripts = override.Builtins, override.Scripts
+	}
+	if override.QueryMode != "" {
+		merged.QueryMode = override.QueryMode
+	}
+	if override.Rate != 0 {
+		merged.Rate = override.Rate
+	}
+	if len(override.Variables) > 0 {
+		merged.Variables = make(map[string]string, len(w.Variables)+len(override.Variables))
+		for name, value := range w.Variables {
+			merged.Variables[name] = value
+		}
+		for name, value := range override.Variables {
+			merged.Variables[name] = value
+		}
+	}
+	return merged
+}
+
+// SummarizeLoad computes throughput and latency of a load run from latencies of completed transactions.
+func SummarizeLoad(completed, failed int64, latencies []time.Duration, elapsed time.Duration) ExternalMetric {
+	metric := ExternalMetric{Transactions: completed, Errors: failed}
+	if len(latencies) == 0 || elapsed <= 0 {
+		return metric
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+
+	var total time.Duration
+	for _, latency := range sorted {
+		total += latency
+	}
+
+	metric.Tps = float64(completed) / elapsed.Seconds()
+	metric.Latency = milliseconds(total) / float64(len(sorted))
+	metric.LatencyP50 = milliseconds(percentile(sorted, 0.50))
+	metric.LatencyP95 = milliseconds(percentile(sorted, 0.95))
+	metric.LatencyP99 = milliseconds(percentile(sorted, 0.99))
+	return metric
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	rank := int(math.Ceil(q*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -6,13 +6,21 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"os"
 	"os/exec"
 	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
 
+var (
+	builtinScripts = []string{"tpcb-like", "simple-update", "select-only"}
+	queryModes     = []string{"simple", "extended", "prepared"}
+)
+
 type Implementation struct {
 	db     *sql.DB
 	config config.Config
@@ -24,7 +32,7 @@ func New(db *sql.DB, config config.Config) *Implementation {
 
 type Bench interface {
 	InitializePgbench(ctx context.Context) error
-	RunPgbench(ctx context.Context) (float64, float64, error)
+	RunPgbench(ctx context.Context, workload model.Workload) (model.ExternalMetric, error)
 }
 
 func (i *Implementation) InitializePgbench(ctx context.Context) error {
@@ -41,11 +49,17 @@ func (i *Implementation) InitializePgbench(ctx context.Context) error {
 	return nil
 }
 
-func (i *Implementation) RunPgbench(ctx context.Context) (float64, float64, error) {
+// RunPgbench runs the workload with fields that are not set taken from the pgbench config.
+func (i *Implementation) RunPgbench(ctx context.Context, workload model.Workload) (model.ExternalMetric, error) {
+	workload = i.configWorkload().Merge(workload)
+	if err := validateWorkload(workload); err != nil {
+		return model.ExternalMetric{}, err
+	}
+
 	baseCommand := "pgbench"
 	var stdout, stderr bytes.Buffer
 
-	cmd := exec.CommandContext(ctx, baseCommand, i.createPgbenchLoadCommand()...)
+	cmd := exec.CommandContext(ctx, baseCommand, i.createPgbenchLoadCommand(workload)...)
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
@@ -55,17 +69,69 @@ func (i *Implementation) RunPgbench(ctx context.Context) (float64, float64, erro
 
 	err := cmd.Run()
 	if err != nil {
-		return 0, 0, fmt.Errorf("exec.Command : %w", err)
+		return model.ExternalMetric{}, fmt.Errorf("exec.Command : %w", err)
 	}
 
-	tps, latency, err := extractMetrics(stdout.String())
+	metric, err := extractMetrics(stdout.String())
 	if err != nil {
-		return 0, 0, fmt.Errorf("extractMetrics: %w", err)
+		return model.ExternalMetric{}, fmt.Errorf("extractMetrics: %w", err)
+	}
+	return metric, nil
+}
+
+func (i *Implementation) configWorkload() model.Workload {
+	toScripts := func(scripts []config.PgbenchScript) []model.WorkloadScript {
+		workloadScripts := make([]model.WorkloadScript, 0, len(scripts))
+		for _, script := range scripts {
+			workloadScripts = append(workloadScripts, model.WorkloadScript{Name: script.Name, Weight: script.Weight})
+		}
+		return workloadScripts
+	}
+
+	return model.Workload{
+		Builtins:  toScripts(i.config.Pgbench.Builtins),
+		Scripts:   toScripts(i.config.Pgbench.Scripts),
+		QueryMode: i.config.Pgbench.QueryMode,
+		Rate:      i.config.Pgbench.Rate,
+		Variables: i.config.Pgbench.Variables,
+	}
+}
+
+func validateWorkload(workload model.Workload) error {
+	for _, script := range workload.Builtins {
+		if !slices.Contains(builtinScripts, script.Name) {
+			return fmt.Errorf("%w: unknown built-in script %q, expected one of %s", model.ErrInvalidWorkload, script.Name, strings.Join(builtinScripts, ", "))
+		}
+		if script.Weight < 0 {
+			return fmt.Errorf("%w: weight of script %s should not be negative", model.ErrInvalidWorkload, script.Name)
+		}
+	}
+	for _, script := range workload.Scripts {
+		if script.Weight < 0 {
+			return fmt.Errorf("%w: weight of script %s should not be negative", model.ErrInvalidWorkload, script.Name)
+		}
+		if strings.Contains(script.Name, "@") {
+			return fmt.Errorf("%w: script path %s should not contain @", model.ErrInvalidWorkload, script.Name)
+		}
+		if _, err := os.Stat(script.Name); err != nil {
+			return fmt.Errorf("%w: script %s: %v", model.ErrInvalidWorkload, script.Name, err)
+		}
+	}
+	if workload.QueryMode != "" && !slices.Contains(queryModes, workload.QueryMode) {
+		return fmt.Errorf("%w: unknown query mode %q, expected one of %s", model.ErrInvalidWorkload, workload.QueryMode, strings.Join(queryModes, ", "))
+	}
+	if workload.Rate < 0 {
+		return fmt.Errorf("%w: rate should not be negative", model.ErrInvalidWorkload)
+	}
+	for name := range workload.Variables {
+		if name == "" || strings.Contains(name, "=") {
+			return fmt.Errorf("%w: invalid variable name %q", model.ErrInvalidWorkload, name)
+		}
 	}
-	return tps, latency, nil
+	return nil
 }
 
-func (i *Implementation) createPgbenchLoadCommand() []string {
+func (i *Implementation) createPgbenchLoadCommand(workload model.Workload) []string {
 	var args []string
 
 	if i.config.Pgbench.NumOfClients != 0 {
@@ -80,11 +146,42 @@ func (i *Implementation) createPgbenchLoadCommand() []string {
 		args = append(args, "-T", fmt.Sprintf("%d", i.config.Pgbench.Duration))
 	}
 
+	for _, script := range workload.Builtins {
+		args = append(args, "-b", scriptArg(script))
+	}
+	for _, script := range workload.Scripts {
+		args = append(args, "-f", scriptArg(script))
+	}
+
+	if workload.QueryMode != "" {
+		args = append(args, "-M", workload.QueryMode)
+	}
+
+	if workload.Rate != 0 {
+		args = append(args, "-R", strconv.FormatFloat(workload.Rate, 'f', -1, 64))
+	}
+
+	names := make([]string, 0, len(workload.Variables))
+	for name := range workload.Variables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		args = append(args, "-D", fmt.Sprintf("%s=%s", name, workload.Variables[name]))
+	}
+
 	args = append(args, "-h", fmt.Sprintf("%s", i.config.PG.Host), "-p", fmt.Sprintf("%d", i.config.PG.Port), "-U", i.config.PG.User, i.config.PG.Database)
 
 	return args
 }
 
+func scriptArg(script model.WorkloadScript) string {
+	if script.Weight == 0 {
+		return script.Name
+	}
+	return fmt.Sprintf("%s@%d", script.Name, script.Weight)
+}
+
 func (i *Implementation) createPgbenchInitCommand() []string {
 	var args []string
 
@@ -105,21 +202,44 @@ func (i *Implementation) createPgbenchInitCommand() []string {
 	return args
 }
 
-func extractMetrics(output string) (float64, float64, error) {
+// extractMetrics parses the pgbench report, pgbench does not report latency percentiles.
+func extractMetrics(output string) (model.ExternalMetric, error) {
 	var (
-		tps, latency float64
-		err          error
+		metric model.ExternalMetric
+		err    error
 	)
 	lines := strings.Split(output, "\n")
 	for _, line := range lines {
+		// totals are followed by per-script statistics when several scripts run
+		if strings.HasPrefix(line, "SQL script") {
+			break
+		}
 		if strings.Contains(line, "tps") {
-			tps, err = extractNumber(line)
+			metric.Tps, err = extractNumber(line)
+		}
+		// with -R pgbench also reports latency stddev and schedule lag
+		if strings.Contains(line, "latency average") {
+			metric.Latency, err = extractNumber(line)
 		}
-		if strings.Contains(line, "latency") {
-			latency, err = extractNumber(line)
+		if strings.HasPrefix(line, "number of transactions actually processed") {
+			metric.Transactions, err = extractCount(line)
 		}
+		// reported since PostgreSQL 15
+		if strings.HasPrefix(line, "number of failed transactions") {
+			metric.Errors, err = extractCount(line)
+		}
+	}
+	return metric, err
+}
+
+var countRegexp = regexp.MustCompile(`:\s*(\d+)`)
+
+func extractCount(s string) (int64, error) {
+	match := countRegexp.FindStringSubmatch(s)
+	if match == nil {
+		return 0, fmt.Errorf("no count found in string")
 	}
-	return tps, latency, err
+	return strconv.ParseInt(match[1], 10, 64)
 }
 
 func extractNumber(s string) (float64, error) {
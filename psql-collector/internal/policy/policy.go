@@ -0,0 +1,148 @@
+package policy
+
+import (
+	"fmt"
+	"slices"
+
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+)
+
+// bounds are policy limits in canonical units, nil means the pg_settings limit is kept.
+// unit is the canonical unit the limits were given in, empty for bare numbers.
+type bounds struct {
+	min, max *float64
+	unit     string
+}
+
+// Policy decides which knobs are tunable and narrows their limits.
+type Policy struct {
+	allow  []string
+	deny   []string
+	bounds map[string]bounds
+}
+
+func New(cfg config.KnobPolicy) (*Policy, error) {
+	p := &Policy{
+		allow:  cfg.Allow,
+		deny:   cfg.Deny,
+		bounds: make(map[string]bounds, len(cfg.Bounds)),
+	}
+
+	for name, knobBounds := range cfg.Bounds {
+		var b bounds
+		if knobBounds.Min != "" {
+			value, unit, err := model.ParseHumanValue(knobBounds.Min)
+			if err != nil {
+				return nil, fmt.Errorf("knob %s min bound: %w", name, err)
+			}
+			b.min = &value
+			b.unit = unit
+		}
+		if knobBounds.Max != "" {
+			value, unit, err := model.ParseHumanValue(knobBounds.Max)
+			if err != nil {
+				return nil, fmt.Errorf("knob %s max bound: %w", name, err)
+			}
+			if b.min != nil && unit != b.unit {
+				return nil, fmt.Errorf("knob %s bounds are given in different units", name)
+			}
+			b.max = &value
+			b.unit = unit
+		}
+		if b.min != nil && b.max != nil && *b.min > *b.max {
+			return nil, fmt.Errorf("knob %s min bound is greater than max bound", name)
+		}
+		p.bounds[name] = b
+	}
+
+	return p, nil
+}
+
+// Validate checks bounds against collected knobs: bounds may only narrow numeric knobs
+// and must be of the same unit kind as the knob, e.g. "1GB" but neither "10s" nor "1024" for work_mem.
+func (p *Policy) Validate(knobs []model.Knob) error {
+	knobsByName := make(map[string]model.Knob, len(knobs))
+	for _, knob := range knobs {
+		knobsByName[knob.Name] = knob
+	}
+
+	for name, b := range p.bounds {
+		knob, ok := knobsByName[name]
+		if !ok {
+			return fmt.Errorf("knob %s with bounds is unknown", name)
+		}
+		if knob.VarType != model.VarTypeInteger && knob.VarType != model.VarTypeReal {
+			return fmt.Errorf("knob %s with bounds is not numeric", name)
+		}
+		if !b.unitMatches(knob.Unit) {
+			return fmt.Errorf("knob %s bounds are in %q, the knob is in %q", name, b.unit, knob.Unit)
+		}
+	}
+	return nil
+}
+
+// unitMatches reports whether limits apply to a knob in canonical unit.
+func (b bounds) unitMatches(unit string) bool {
+	return b.unit == unit
+}
+
+// IsAllowed reports whether the knob may be changed.
+func (p *Policy) IsAllowed(name string) bool {
+	if slices.Contains(p.deny, name) {
+		return false
+	}
+	return len(p.allow) == 0 || slices.Contains(p.allow, name)
+}
+
+// Apply drops knobs that are not allowed and narrows limits of the remaining ones.
+func (p *Policy) Apply(knobs []model.Knob) []model.Knob {
+	allowed := make([]model.Knob, 0, len(knobs))
+	for _, knob := range knobs {
+		if !knob.IsTunable() || !p.IsAllowed(knob.Name) {
+			continue
+		}
+
+		b := p.bounds[knob.Name]
+		if !b.unitMatches(knob.Unit) {
+			// Validate rejects such bounds at startup
+			b = bounds{}
+		}
+		if minVal, ok := knob.MinVal.(float64); ok && b.min != nil {
+			knob.MinVal = max(minVal, *b.min)
+		}
+		if maxVal, ok := knob.MaxVal.(float64); ok && b.max != nil {
+			knob.MaxVal = min(maxVal, *b.max)
+		}
+		allowed = append(allowed, knob)
+	}
+	return allowed
+}
+
+// Check returns model.ErrKnobForbidden for knobs that are not allowed and
+// model.ErrInvalidKnob for values outside of policy bounds or values of bounded knobs
+// that are not numbers of the bounds' unit kind.
+// Knobs without bounds (bool, enum and string knobs among them) are only checked against the lists.
+func (p *Policy) Check(knob model.Knob) error {
+	if !p.IsAllowed(knob.Name) {
+		return fmt.Errorf("%w: %s", model.ErrKnobForbidden, knob.Name)
+	}
+
+	b, ok := p.bounds[knob.Name]
+	if !ok {
+		return nil
+	}
+
+	value, err := model.ToCanonicalValue(knob.Value, b.unit)
+	if err != nil {
+		return fmt.Errorf("%w: knob %s: %v", model.ErrInvalidKnob, knob.Name, err)
+	}
+
+	if b.min != nil && value < *b.min {
+		return fmt.Errorf("%w: knob %s: value %v is less than policy minimum %v", model.ErrInvalidKnob, knob.Name, value, *b.min)
+	}
+	if b.max != nil && value > *b.max {
+		return fmt.Errorf("%w: knob %s: value %v is greater than policy maximum %v", model.ErrInvalidKnob, knob.Name, value, *b.max)
+	}
+	return nil
+}
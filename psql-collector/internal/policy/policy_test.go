@@ -0,0 +1,190 @@
+package policy
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		bounds  map[string]config.KnobBounds
+		wantErr bool
+	}{
+		{name: "no bounds"},
+		{name: "memory bounds", bounds: map[string]config.KnobBounds{"work_mem": {Min: "1MB", Max: "1GB"}}},
+		{name: "min only", bounds: map[string]config.KnobBounds{"max_connections": {Min: "10"}}},
+		{name: "units of different kinds", bounds: map[string]config.KnobBounds{"work_mem": {Min: "1MB", Max: "10s"}}, wantErr: true},
+		{name: "bare number with unit", bounds: map[string]config.KnobBounds{"work_mem": {Min: "1024", Max: "1GB"}}, wantErr: true},
+		{name: "min greater than max", bounds: map[string]config.KnobBounds{"work_mem": {Min: "1GB", Max: "512MB"}}, wantErr: true},
+		{name: "unknown unit", bounds: map[string]config.KnobBounds{"work_mem": {Max: "1XB"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(config.KnobPolicy{Bounds: tt.bounds})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsAllowed(t *testing.T) {
+	tests := []struct {
+		name  string
+		allow []string
+		deny  []string
+		knob  string
+		want  bool
+	}{
+		{name: "empty lists allow everything", knob: "work_mem", want: true},
+		{name: "denied", deny: []string{"fsync"}, knob: "fsync", want: false},
+		{name: "not denied", deny: []string{"fsync"}, knob: "work_mem", want: true},
+		{name: "allowed", allow: []string{"work_mem"}, knob: "work_mem", want: true},
+		{name: "not in allow list", allow: []string{"work_mem"}, knob: "shared_buffers", want: false},
+		{name: "deny takes precedence over allow", allow: []string{"fsync"}, deny: []string{"fsync"}, knob: "fsync", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mustNew(t, config.KnobPolicy{Allow: tt.allow, Deny: tt.deny})
+			if got := p.IsAllowed(tt.knob); got != tt.want {
+				t.Errorf("IsAllowed(%q) = %v, want %v", tt.knob, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	workMem := model.Knob{Name: "work_mem", VarType: model.VarTypeInteger, Unit: model.UnitBytes, Value: 4194304., MinVal: 65536., MaxVal: 2199023254528.}
+	fsync := model.Knob{Name: "fsync", VarType: model.VarTypeBool, Value: true}
+	blockSize := model.Knob{Name: "block_size", VarType: model.VarTypeInteger, Value: 8192., MinVal: 8192., MaxVal: 8192., Context: model.ContextInternal}
+
+	tests := []struct {
+		name   string
+		policy config.KnobPolicy
+		knobs  []model.Knob
+		want   []model.Knob
+	}{
+		{
+			name:   "denied and internal knobs are dropped",
+			policy: config.KnobPolicy{Deny: []string{"fsync"}},
+			knobs:  []model.Knob{workMem, fsync, blockSize},
+			want:   []model.Knob{workMem},
+		},
+		{
+			name:   "bounds are converted to bytes and narrow limits",
+			policy: config.KnobPolicy{Bounds: map[string]config.KnobBounds{"work_mem": {Min: "1MB", Max: "1GB"}}},
+			knobs:  []model.Knob{workMem},
+			want:   []model.Knob{withLimits(workMem, 1048576., 1073741824.)},
+		},
+		{
+			name:   "bounds never widen limits",
+			policy: config.KnobPolicy{Bounds: map[string]config.KnobBounds{"work_mem": {Min: "1kB", Max: "4TB"}}},
+			knobs:  []model.Knob{workMem},
+			want:   []model.Knob{workMem},
+		},
+		{
+			name:   "bounds of another unit kind are ignored",
+			policy: config.KnobPolicy{Bounds: map[string]config.KnobBounds{"work_mem": {Max: "10s"}}},
+			knobs:  []model.Knob{workMem},
+			want:   []model.Knob{workMem},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mustNew(t, tt.policy)
+			if got := p.Apply(tt.knobs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	p := mustNew(t, config.KnobPolicy{
+		Deny: []string{"fsync"},
+		Bounds: map[string]config.KnobBounds{
+			"work_mem":        {Min: "1MB", Max: "1GB"},
+			"max_connections": {Max: "500"},
+		},
+	})
+
+	tests := []struct {
+		name    string
+		knob    model.Knob
+		wantErr error
+	}{
+		{name: "denied", knob: model.Knob{Name: "fsync", Value: false}, wantErr: model.ErrKnobForbidden},
+		{name: "without bounds", knob: model.Knob{Name: "wal_level", Value: "replica"}},
+		{name: "canonical value in bounds", knob: model.Knob{Name: "work_mem", Value: 4194304.}},
+		{name: "value with unit in bounds", knob: model.Knob{Name: "work_mem", Value: "64MB"}},
+		{name: "at max bound", knob: model.Knob{Name: "work_mem", Value: "1GB"}},
+		{name: "below min bound", knob: model.Knob{Name: "work_mem", Value: "512kB"}, wantErr: model.ErrInvalidKnob},
+		{name: "above max bound", knob: model.Knob{Name: "work_mem", Value: 2147483648.}, wantErr: model.ErrInvalidKnob},
+		{name: "value of another unit kind", knob: model.Knob{Name: "work_mem", Value: "10s"}, wantErr: model.ErrInvalidKnob},
+		{name: "not a number", knob: model.Knob{Name: "work_mem", Value: true}, wantErr: model.ErrInvalidKnob},
+		{name: "unitless above max bound", knob: model.Knob{Name: "max_connections", Value: 1000.}, wantErr: model.ErrInvalidKnob},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Check(tt.knob)
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	knobs := []model.Knob{
+		{Name: "work_mem", VarType: model.VarTypeInteger, Unit: model.UnitBytes},
+		{Name: "max_connections", VarType: model.VarTypeInteger},
+		{Name: "fsync", VarType: model.VarTypeBool},
+	}
+
+	tests := []struct {
+		name    string
+		bounds  map[string]config.KnobBounds
+		wantErr bool
+	}{
+		{name: "memory bounds on memory knob", bounds: map[string]config.KnobBounds{"work_mem": {Max: "1GB"}}},
+		{name: "bare bounds on unitless knob", bounds: map[string]config.KnobBounds{"max_connections": {Max: "500"}}},
+		{name: "bare bounds on memory knob", bounds: map[string]config.KnobBounds{"work_mem": {Max: "1024"}}, wantErr: true},
+		{name: "time bounds on memory knob", bounds: map[string]config.KnobBounds{"work_mem": {Max: "10s"}}, wantErr: true},
+		{name: "bounds on bool knob", bounds: map[string]config.KnobBounds{"fsync": {Max: "1"}}, wantErr: true},
+		{name: "bounds on unknown knob", bounds: map[string]config.KnobBounds{"no_such_knob": {Max: "1"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mustNew(t, config.KnobPolicy{Bounds: tt.bounds})
+			if err := p.Validate(knobs); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func mustNew(t *testing.T, cfg config.KnobPolicy) *Policy {
+	t.Helper()
+
+	p, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return p
+}
+
+func withLimits(knob model.Knob, minVal, maxVal float64) model.Knob {
+	knob.MinVal = minVal
+	knob.MaxVal = maxVal
+	return knob
+}
@@ -0,0 +1,38 @@
+package policy
+
+import (
+	"fmt"
+	"slices"
+
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+)
+
+// TableOptionPolicy decides which storage parameters may be set on tables.
+type TableOptionPolicy struct {
+	allow []string
+	deny  []string
+}
+
+func NewTableOptions(cfg config.TableOptionPolicy) *TableOptionPolicy {
+	return &TableOptionPolicy{
+		allow: cfg.Allow,
+		deny:  cfg.Deny,
+	}
+}
+
+// IsAllowed reports whether the storage parameter may be changed.
+func (p *TableOptionPolicy) IsAllowed(name string) bool {
+	if slices.Contains(p.deny, name) {
+		return false
+	}
+	return len(p.allow) == 0 || slices.Contains(p.allow, name)
+}
+
+// Check returns model.ErrTableOptionForbidden for storage parameters that are not allowed.
+func (p *TableOptionPolicy) Check(option model.TableOption) error {
+	if !p.IsAllowed(option.Name) {
+		return fmt.Errorf("%w: %s", model.ErrTableOptionForbidden, option.Name)
+	}
+	return nil
+}
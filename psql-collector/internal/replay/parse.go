@@ -0,0 +1,335 @@
+package replay
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	formatCSVLog  = "csvlog"
+	formatJSONLog = "jsonlog"
+	formatCapture = "capture"
+
+	// log timestamps end with the log_timezone abbreviation, or with a numeric offset
+	// for zones that have none
+	logTimeLayout       = "2006-01-02 15:04:05.000 MST"
+	logTimeOffsetLayout = "2006-01-02 15:04:05.000 -0700"
+	logTimeHoursLayout  = "2006-01-02 15:04:05.000 -07"
+	logTimeColonLayout  = "2006-01-02 15:04:05.000 -07:00"
+)
+
+// csvlog columns, they are the same since PostgreSQL 9.0, newer versions append columns
+const (
+	csvLogTime       = 0
+	csvDatabaseName  = 2
+	csvSessionID     = 5
+	csvErrorSeverity = 11
+	csvMessage       = 13
+	csvDetail        = 14
+	csvMinColumns    = 15
+)
+
+// durationMessage matches statements logged with log_min_duration_statement, simple protocol
+// statements are logged as "statement:" and extended protocol ones as "execute <name>:".
+// Parse and bind steps are logged as well and are skipped.
+var durationMessage = regexp.MustCompile(`(?s)^duration: ([0-9.]+) ms\s+(?:statement|execute [^:]*): (.*)$`)
+
+// statement is a logged statement of a session.
+type statement struct {
+	sessionID string
+	database  string
+	start     time.Time
+	sql       string
+	params    []any
+	// truncated is set when parameters were cut by log_parameter_max_length
+	truncated bool
+}
+
+type logRecord struct {
+	time      time.Time
+	sessionID string
+	database  string
+	severity  string
+	message   string
+	detail    string
+}
+
+// parseStatements reads statements of a log in format, zone abbreviations of log timestamps
+// are resolved in loc, the log_timezone of the captured server.
+func parseStatements(r io.Reader, format string, loc *time.Location) ([]statement, error) {
+	var (
+		records []logRecord
+		err     error
+	)
+	switch format {
+	case formatCSVLog:
+		records, err = readCSVLog(r, loc)
+	case formatJSONLog:
+		records, err = readJSONLog(r, loc)
+	case formatCapture:
+		return readCapture(r)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected %s, %s or %s", format, formatCSVLog, formatJSONLog, formatCapture)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []statement
+	for _, record := range records {
+		if record.severity != "LOG" {
+			continue
+		}
+		match := durationMessage.FindStringSubmatch(record.message)
+		if match == nil {
+			continue
+		}
+
+		duration, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("strconv.ParseFloat: %w", err)
+		}
+		params, truncated, err := parseParameters(record.detail)
+		if err != nil {
+			return nil, fmt.Errorf("session %s: %w", record.sessionID, err)
+		}
+
+		statements = append(statements, statement{
+			sessionID: record.sessionID,
+			database:  record.database,
+			// log time is when the statement finished
+			start:     record.time.Add(-time.Duration(duration * float64(time.Millisecond))),
+			sql:       match[2],
+			params:    params,
+			truncated: truncated,
+		})
+	}
+	return statements, nil
+}
+
+func readCSVLog(r io.Reader, loc *time.Location) ([]logRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	var records []logRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reader.Read: %w", err)
+		}
+		if len(row) < csvMinColumns {
+			return nil, fmt.Errorf("csvlog row has %d columns, expected at least %d", len(row), csvMinColumns)
+		}
+
+		logTime, err := parseLogTime(row[csvLogTime], loc)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, logRecord{
+			time:      logTime,
+			sessionID: row[csvSessionID],
+			database:  row[csvDatabaseName],
+			severity:  row[csvErrorSeverity],
+			message:   row[csvMessage],
+			detail:    row[csvDetail],
+		})
+	}
+}
+
+type jsonLogRecord struct {
+	Timestamp     string `json:"timestamp"`
+	SessionID     string `json:"session_id"`
+	Database      string `json:"dbname"`
+	ErrorSeverity string `json:"error_severity"`
+	Message       string `json:"message"`
+	Detail        string `json:"detail"`
+}
+
+func readJSONLog(r io.Reader, loc *time.Location) ([]logRecord, error) {
+	var records []logRecord
+	err := scanLines(r, func(line []byte) error {
+		var record jsonLogRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		logTime, err := parseLogTime(record.Timestamp, loc)
+		if err != nil {
+			return err
+		}
+		records = append(records, logRecord{
+			time:      logTime,
+			sessionID: record.SessionID,
+			database:  record.Database,
+			severity:  record.ErrorSeverity,
+			message:   record.Message,
+			detail:    record.Detail,
+		})
+		return nil
+	})
+	return records, err
+}
+
+// parseLogTime parses a log timestamp. Numeric offsets such as +0530 or -03 are parsed as is,
+// zone abbreviations must be defined in loc: Go parses unknown ones with a zero offset, which
+// would silently shift statements, e.g. after a switch from CET to CEST.
+func parseLogTime(value string, loc *time.Location) (time.Time, error) {
+	i := strings.LastIndexByte(value, ' ')
+	if i < 0 {
+		return time.Time{}, fmt.Errorf("invalid log time %q", value)
+	}
+	zone := value[i+1:]
+
+	if strings.HasPrefix(zone, "+") || strings.HasPrefix(zone, "-") {
+		layout := logTimeOffsetLayout
+		switch {
+		case len(zone) == len("+07"):
+			layout = logTimeHoursLayout
+		case strings.Contains(zone, ":"):
+			layout = logTimeColonLayout
+		}
+		logTime, err := time.Parse(layout, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("time.Parse: %w", err)
+		}
+		return logTime, nil
+	}
+
+	logTime, err := time.ParseInLocation(logTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("time.ParseInLocation: %w", err)
+	}
+	if logTime.Location() != loc && zone != "UTC" && zone != "GMT" {
+		return time.Time{}, fmt.Errorf("time zone %q of log time %q is not defined in %s, set load_generator.timezone to the log_timezone of the captured server", zone, value, loc)
+	}
+	return logTime, nil
+}
+
+// captureRecord is a line of a capture file, a JSON lines format for traffic recorded by other tools.
+type captureRecord struct {
+	SessionID  string    `json:"session_id"`
+	Database   string    `json:"database"`
+	Start      time.Time `json:"start"`
+	Statement  string    `json:"statement"`
+	Parameters []*string `json:"parameters"`
+}
+
+func readCapture(r io.Reader) ([]statement, error) {
+	var statements []statement
+	err := scanLines(r, func(line []byte) error {
+		var record captureRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		params := make([]any, 0, len(record.Parameters))
+		for _, param := range record.Parameters {
+			if param == nil {
+				params = append(params, nil)
+				continue
+			}
+			params = append(params, *param)
+		}
+		statements = append(statements, statement{
+			sessionID: record.SessionID,
+			database:  record.Database,
+			start:     record.Start,
+			sql:       record.Statement,
+			params:    params,
+		})
+		return nil
+	})
+	return statements, err
+}
+
+func scanLines(r io.Reader, parse func(line []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		if err := parse(line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanner.Scan: %w", err)
+	}
+	return nil
+}
+
+// parseParameters parses bound parameters of extended protocol statements from the log detail,
+// e.g. "parameters: $1 = '42', $2 = NULL". Values are passed as text like the original client did.
+// truncated is set when a value ends with the "..." log_parameter_max_length appends to cut values,
+// values that really end with "..." can not be told apart from them.
+func parseParameters(detail string) ([]any, bool, error) {
+	rest, ok := strings.CutPrefix(detail, "parameters: ")
+	if !ok {
+		return nil, false, nil
+	}
+
+	var (
+		params    []any
+		truncated bool
+	)
+	for len(rest) > 0 {
+		if !strings.HasPrefix(rest, "$") {
+			return nil, false, fmt.Errorf("invalid parameters %q", detail)
+		}
+		_, rest, ok = strings.Cut(rest, " = ")
+		if !ok {
+			return nil, false, fmt.Errorf("invalid parameters %q", detail)
+		}
+
+		switch {
+		case strings.HasPrefix(rest, "NULL"):
+			params = append(params, nil)
+			rest = rest[len("NULL"):]
+		case strings.HasPrefix(rest, "'"):
+			value, n, err := unquote(rest)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid parameters %q: %w", detail, err)
+			}
+			params = append(params, value)
+			truncated = truncated || strings.HasSuffix(value, "...")
+			rest = rest[n:]
+		default:
+			return nil, false, fmt.Errorf("invalid parameters %q", detail)
+		}
+
+		rest = strings.TrimPrefix(rest, ", ")
+	}
+	return params, truncated, nil
+}
+
+// unquote reads a single-quoted literal with doubled quotes from the start of s,
+// it returns the value and the length of the literal.
+func unquote(s string) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != '\'' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '\'' {
+			b.WriteByte('\'')
+			i++
+			continue
+		}
+		return b.String(), i + 1, nil
+	}
+	return "", 0, fmt.Errorf("unterminated literal")
+}
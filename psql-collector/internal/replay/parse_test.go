@@ -0,0 +1,194 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParseStatements(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		format  string
+		log     string
+		loc     *time.Location
+		want    []statement
+		wantErr bool
+	}{
+		{
+			name:   "csvlog simple statement",
+			format: formatCSVLog,
+			log:    csvLog(t, []string{"2024-05-01 10:00:00.500 UTC", "bench", "s1", "LOG", "duration: 500.000 ms  statement: SELECT 1", ""}),
+			loc:    time.UTC,
+			want: []statement{
+				{sessionID: "s1", database: "bench", start: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), sql: "SELECT 1"},
+			},
+		},
+		{
+			name:   "csvlog execute with parameters and numeric offset",
+			format: formatCSVLog,
+			log: csvLog(t,
+				[]string{"2024-05-01 15:30:00.000 +0530", "bench", "s1", "LOG", "duration: 0.000 ms  parse <unnamed>: SELECT $1", ""},
+				[]string{"2024-05-01 15:30:00.000 +0530", "bench", "s1", "LOG", "duration: 0.000 ms  bind <unnamed>: SELECT $1", "parameters: $1 = '42'"},
+				[]string{"2024-05-01 15:30:01.000 +0530", "bench", "s1", "LOG", "duration: 1000.000 ms  execute <unnamed>: SELECT $1", "parameters: $1 = '42'"},
+				[]string{"2024-05-01 15:30:02.000 +0530", "bench", "s1", "ERROR", "relation \"t\" does not exist", ""},
+			),
+			loc: time.UTC,
+			want: []statement{
+				{sessionID: "s1", database: "bench", start: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), sql: "SELECT $1", params: []any{"42"}},
+			},
+		},
+		{
+			name:   "csvlog abbreviations across a daylight saving change",
+			format: formatCSVLog,
+			log: csvLog(t,
+				[]string{"2024-03-31 01:59:00.000 CET", "bench", "s1", "LOG", "duration: 0.000 ms  statement: SELECT 1", ""},
+				[]string{"2024-03-31 03:01:00.000 CEST", "bench", "s1", "LOG", "duration: 0.000 ms  statement: SELECT 2", ""},
+			),
+			loc: berlin,
+			want: []statement{
+				{sessionID: "s1", database: "bench", start: time.Date(2024, 3, 31, 0, 59, 0, 0, time.UTC), sql: "SELECT 1"},
+				{sessionID: "s1", database: "bench", start: time.Date(2024, 3, 31, 1, 1, 0, 0, time.UTC), sql: "SELECT 2"},
+			},
+		},
+		{
+			name:    "csvlog abbreviation unknown in location",
+			format:  formatCSVLog,
+			log:     csvLog(t, []string{"2024-03-31 03:01:00.000 CEST", "bench", "s1", "LOG", "duration: 0.000 ms  statement: SELECT 1", ""}),
+			loc:     time.UTC,
+			wantErr: true,
+		},
+		{
+			name:    "csvlog too few columns",
+			format:  formatCSVLog,
+			log:     "2024-05-01 10:00:00.000 UTC,postgres,bench\n",
+			loc:     time.UTC,
+			wantErr: true,
+		},
+		{
+			name:   "jsonlog hours offset",
+			format: formatJSONLog,
+			log: `{"timestamp":"2024-05-01 07:00:00.250 -03","session_id":"s2","dbname":"bench","error_severity":"LOG","message":"duration: 250.000 ms  statement: UPDATE t SET a = 1"}
+{"timestamp":"2024-05-01 07:00:01.000 -03","session_id":"s2","dbname":"bench","error_severity":"LOG","message":"connection authorized: user=postgres"}
+`,
+			loc: time.UTC,
+			want: []statement{
+				{sessionID: "s2", database: "bench", start: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), sql: "UPDATE t SET a = 1"},
+			},
+		},
+		{
+			name:   "jsonlog truncated parameters",
+			format: formatJSONLog,
+			log: `{"timestamp":"2024-05-01 10:00:00.000 UTC","session_id":"s3","dbname":"bench","error_severity":"LOG","message":"duration: 0.000 ms  execute s1: SELECT $1","detail":"parameters: $1 = 'abc...'"}
+`,
+			loc: time.UTC,
+			want: []statement{
+				{sessionID: "s3", database: "bench", start: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), sql: "SELECT $1", params: []any{"abc..."}, truncated: true},
+			},
+		},
+		{
+			name:   "capture",
+			format: formatCapture,
+			log: `{"session_id":"s4","database":"bench","start":"2024-05-01T10:00:00Z","statement":"SELECT $1, $2","parameters":["x",null]}
+`,
+			loc: time.UTC,
+			want: []statement{
+				{sessionID: "s4", database: "bench", start: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), sql: "SELECT $1, $2", params: []any{"x", nil}},
+			},
+		},
+		{
+			name:    "unknown format",
+			format:  "stderr",
+			loc:     time.UTC,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStatements(strings.NewReader(tt.log), tt.format, tt.loc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseStatements() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseStatements() returned %d statements, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if !got[i].start.Equal(tt.want[i].start) {
+					t.Errorf("statement %d start = %v, want %v", i, got[i].start, tt.want[i].start)
+				}
+				got[i].start, tt.want[i].start = time.Time{}, time.Time{}
+				if !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("statement %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseParameters(t *testing.T) {
+	tests := []struct {
+		name          string
+		detail        string
+		want          []any
+		wantTruncated bool
+		wantErr       bool
+	}{
+		{name: "no parameters", detail: "", want: nil},
+		{name: "other detail", detail: "Key (id)=(1) already exists.", want: nil},
+		{name: "single value", detail: "parameters: $1 = '42'", want: []any{"42"}},
+		{name: "null", detail: "parameters: $1 = NULL, $2 = 'x'", want: []any{nil, "x"}},
+		{name: "doubled quotes", detail: "parameters: $1 = 'it''s', $2 = ''''", want: []any{"it's", "'"}},
+		{name: "separator in value", detail: "parameters: $1 = 'a, $2 = b', $2 = 'c'", want: []any{"a, $2 = b", "c"}},
+		{name: "truncated value", detail: "parameters: $1 = 'long...', $2 = '1'", want: []any{"long...", "1"}, wantTruncated: true},
+		{name: "unterminated literal", detail: "parameters: $1 = 'abc", wantErr: true},
+		{name: "missing placeholder", detail: "parameters: 1 = '1'", wantErr: true},
+		{name: "unquoted value", detail: "parameters: $1 = 42", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated, err := parseParameters(tt.detail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseParameters(%q) error = %v, wantErr %v", tt.detail, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseParameters(%q) = %#v, want %#v", tt.detail, got, tt.want)
+			}
+			if truncated != tt.wantTruncated {
+				t.Errorf("parseParameters(%q) truncated = %v, want %v", tt.detail, truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+// csvLog renders csvlog rows from time, database, session id, severity, message and detail.
+func csvLog(t *testing.T, rows ...[]string) string {
+	t.Helper()
+
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	for _, row := range rows {
+		record := make([]string, csvMinColumns+8)
+		record[csvLogTime] = row[0]
+		record[csvDatabaseName] = row[1]
+		record[csvSessionID] = row[2]
+		record[csvErrorSeverity] = row[3]
+		record[csvMessage] = row[4]
+		record[csvDetail] = row[5]
+		if err := w.Write(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+	return b.String()
+}
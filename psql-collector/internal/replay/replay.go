@@ -0,0 +1,279 @@
+package replay
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSpeed       = 1.0
+	defaultMaxSessions = 50
+	defaultDuration    = 10 * time.Minute
+)
+
+// Implementation replays sessions captured in PostgreSQL logs with their original timing.
+// It is used instead of pgbench when the replay engine is configured.
+type Implementation struct {
+	db          *sql.DB
+	speed       float64
+	maxSessions int
+	duration    time.Duration
+	// sessions hold statements of each captured session ordered by start,
+	// offsets are relative to the first captured statement
+	sessions [][]timedStatement
+}
+
+type timedStatement struct {
+	offset time.Duration
+	sql    string
+	params []any
+}
+
+func New(db *sql.DB, cfg config.LoadGenerator) (*Implementation, error) {
+	format := cfg.Format
+	if format == "" {
+		format = formatFromExtension(cfg.Log)
+	}
+
+	file, err := os.Open(cfg.Log)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+	defer file.Close()
+
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("time.LoadLocation: %w", err)
+	}
+
+	statements, err := parseStatements(file, format, loc)
+	if err != nil {
+		return nil, fmt.Errorf("parseStatements: %w", err)
+	}
+	if cfg.Database != "" {
+		statements = slices.DeleteFunc(statements, func(s statement) bool {
+			return s.database != cfg.Database
+		})
+	}
+	truncated := len(statements)
+	statements = slices.DeleteFunc(statements, func(s statement) bool {
+		return s.truncated
+	})
+	if truncated -= len(statements); truncated > 0 {
+		log.Printf("replay: skipped %d statements with parameters truncated by log_parameter_max_length, set it to -1 to replay them", truncated)
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("no statements to replay in %s, log_min_duration_statement = 0 is required", cfg.Log)
+	}
+
+	speed := cfg.Speed
+	if speed <= 0 {
+		speed = defaultSpeed
+	}
+	maxSessions := cfg.MaxSessions
+	if maxSessions <= 0 {
+		maxSessions = defaultMaxSessions
+	}
+	duration := cfg.Duration
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+
+	return &Implementation{
+		db:          db,
+		speed:       speed,
+		maxSessions: maxSessions,
+		duration:    duration,
+		sessions:    toSessions(statements),
+	}, nil
+}
+
+func formatFromExtension(path string) string {
+	switch filepath.Ext(path) {
+	case ".csv":
+		return formatCSVLog
+	case ".json":
+		return formatJSONLog
+	default:
+		return formatCapture
+	}
+}
+
+func toSessions(statements []statement) [][]timedStatement {
+	first := slices.MinFunc(statements, func(a, b statement) int {
+		return a.start.Compare(b.start)
+	}).start
+
+	bySession := make(map[string][]statement)
+	var order []string
+	for _, s := range statements {
+		if _, ok := bySession[s.sessionID]; !ok {
+			order = append(order, s.sessionID)
+		}
+		bySession[s.sessionID] = append(bySession[s.sessionID], s)
+	}
+
+	sessions := make([][]timedStatement, 0, len(order))
+	for _, id := range order {
+		sessionStatements := bySession[id]
+		slices.SortStableFunc(sessionStatements, func(a, b statement) int {
+			return a.start.Compare(b.start)
+		})
+
+		timed := make([]timedStatement, 0, len(sessionStatements))
+		for _, s := range sessionStatements {
+			timed = append(timed, timedStatement{offset: s.start.Sub(first), sql: s.sql, params: s.params})
+		}
+		sessions = append(sessions, timed)
+	}
+
+	// sessions are started in the order they were captured
+	slices.SortStableFunc(sessions, func(a, b []timedStatement) int {
+		return cmp.Compare(a[0].offset, b[0].offset)
+	})
+	return sessions
+}
+
+// InitializePgbench does nothing, the replayed database is expected to have the captured schema and data.
+func (i *Implementation) InitializePgbench(context.Context) error {
+	return nil
+}
+
+// RunPgbench replays captured sessions, each over its own connection, at most max sessions at once and
+// for at most the configured duration. Statements start at their original offsets divided by the configured
+// speed, or right after the previous statement of the session when the replay falls behind, e.g. waiting
+// for a free session. Transactions and TPS count statements.
+func (i *Implementation) RunPgbench(ctx context.Context, workload model.Workload) (model.ExternalMetric, error) {
+	if len(workload.Builtins) > 0 || len(workload.Scripts) > 0 {
+		return model.ExternalMetric{}, fmt.Errorf("%w: replay runs the configured log, scripts are not supported", model.ErrInvalidWorkload)
+	}
+	if workload.Rate != 0 || workload.QueryMode != "" || len(workload.Variables) > 0 {
+		return model.ExternalMetric{}, fmt.Errorf("%w: replay keeps the captured timing and parameters, rate, query mode and variables are not supported", model.ErrInvalidWorkload)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, i.duration)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = make([]sessionResult, 0, len(i.sessions))
+		slots   = make(chan struct{}, i.maxSessions)
+	)
+
+	start := time.Now()
+sessions:
+	for _, session := range i.sessions {
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+			break sessions
+		}
+
+		wg.Add(1)
+		go func(session []timedStatement) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			result := i.replaySession(runCtx, start, session)
+
+			mu.Lock()
+			defer mu.Unlock()
+			results = append(results, result)
+		}(session)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	if err := ctx.Err(); err != nil {
+		return model.ExternalMetric{}, err
+	}
+	return summarize(results, elapsed), nil
+}
+
+type sessionResult struct {
+	statements int64
+	errors     int64
+	latencies  []time.Duration
+}
+
+func (i *Implementation) replaySession(ctx context.Context, start time.Time, session []timedStatement) sessionResult {
+	var result sessionResult
+
+	// the connection is opened when the session starts, as the original client did
+	if err := i.waitUntil(ctx, start, session[0].offset); err != nil {
+		return result
+	}
+	conn, err := i.db.Conn(ctx)
+	if err != nil {
+		log.Println(fmt.Errorf("db.Conn: %w", err))
+		result.errors += int64(len(session))
+		return result
+	}
+	// replayed statements may leave a transaction open or change session settings,
+	// so the connection is closed instead of being returned to the shared pool
+	defer conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+
+	for _, s := range session {
+		if err := i.waitUntil(ctx, start, s.offset); err != nil {
+			return result
+		}
+
+		statementStart := time.Now()
+		_, err := conn.ExecContext(ctx, s.sql, s.params...)
+		if ctx.Err() != nil {
+			return result
+		}
+		if err != nil {
+			// failures are expected when the target differs from the captured database
+			result.errors++
+			continue
+		}
+		result.statements++
+		result.latencies = append(result.latencies, time.Since(statementStart))
+	}
+	return result
+}
+
+func (i *Implementation) waitUntil(ctx context.Context, start time.Time, offset time.Duration) error {
+	wait := time.Until(start.Add(time.Duration(float64(offset) / i.speed)))
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func summarize(results []sessionResult, elapsed time.Duration) model.ExternalMetric {
+	var (
+		completed, failed int64
+		latencies         []time.Duration
+	)
+	for _, result := range results {
+		completed += result.statements
+		failed += result.errors
+		latencies = append(latencies, result.latencies...)
+	}
+	return model.SummarizeLoad(completed, failed, latencies, elapsed)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"postgresHelper/internal/config"
 	"postgresHelper/internal/model"
 	"time"
 )
@@ -12,53 +13,142 @@ const (
 	defaultCollectInterval = time.Second * 10
 )
 
+// defaultGroups are sampled every collect interval when no groups are configured,
+// autovacuum and bloat scan every table and index and are left out.
+var defaultGroups = []model.MetricGroup{
+	model.MetricGroupActivity,
+	model.MetricGroupLocks,
+	model.MetricGroupReplication,
+	model.MetricGroupTables,
+}
+
 type Runner interface {
 	Run(ctx context.Context)
 }
 
 type Implementation struct {
 	collect         Collector
+	metrics         MetricsSampler
 	storage         Storager
 	collectInterval time.Duration
+	groups          []*groupSchedule
+}
+
+// groupSchedule is a metric group sampled every interval, next is when it is due.
+type groupSchedule struct {
+	group    model.MetricGroup
+	interval time.Duration
+	next     time.Time
 }
 
+// Collector lists knobs with the knob policy applied, so the history holds only the permitted action space.
 type Collector interface {
-	CollectKnobs(ctx context.Context) ([]model.Knob, error)
+	ListKnobs(ctx context.Context) ([]model.Knob, error)
+}
+
+type MetricsSampler interface {
+	SampleAggregatedMetrics(ctx context.Context, groups []model.MetricGroup) ([]model.InternalMetric, error)
 }
 
 type Storager interface {
 	SetKnobs(knobs []model.Knob)
 	GetKnobs() []model.Knob
+	AddKnobSample(sample model.KnobSample)
+	AddMetricSample(sample model.MetricSample)
 }
 
-func New(collect Collector, storage Storager) *Implementation {
+func New(collect Collector, metrics MetricsSampler, storage Storager, cfg config.History) (*Implementation, error) {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultCollectInterval
+	}
+
+	var groups []*groupSchedule
+	for name, groupInterval := range cfg.Groups {
+		group, err := model.ParseMetricGroup(name)
+		if err != nil {
+			return nil, fmt.Errorf("history groups: %w", err)
+		}
+		groups = append(groups, &groupSchedule{group: group, interval: max(groupInterval, interval)})
+	}
+	if len(cfg.Groups) == 0 {
+		for _, group := range defaultGroups {
+			groups = append(groups, &groupSchedule{group: group, interval: interval})
+		}
+	}
+
 	return &Implementation{
 		collect:         collect,
+		metrics:         metrics,
 		storage:         storage,
-		collectInterval: defaultCollectInterval,
-	}
+		collectInterval: interval,
+		groups:          groups,
+	}, nil
 }
 
-func (i *Implementation) Run() {
-	ctx := context.Background()
+// Groups returns the metric groups the runner samples.
+func (i *Implementation) Groups() []model.MetricGroup {
+	groups := make([]model.MetricGroup, 0, len(i.groups))
+	for _, schedule := range i.groups {
+		groups = append(groups, schedule.group)
+	}
+	return groups
+}
 
+// Run collects knobs into the storage every collect interval and metric groups when they are due
+// until ctx is done.
+func (i *Implementation) Run(ctx context.Context) {
 	go func() {
 		ticker := time.NewTicker(i.collectInterval)
 		defer ticker.Stop()
 
+		i.collectOnce(ctx)
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				knobs, err := i.collect.CollectKnobs(ctx)
-				if err != nil {
-					log.Println(err)
-					continue
-				}
-				i.storage.SetKnobs(knobs)
-				fmt.Println("Collected knobs")
+				i.collectOnce(ctx)
 			}
 		}
 	}()
 }
+
+func (i *Implementation) collectOnce(ctx context.Context) {
+	knobs, err := i.collect.ListKnobs(ctx)
+	if err != nil {
+		log.Println(fmt.Errorf("collect.ListKnobs: %w", err))
+	} else {
+		i.storage.SetKnobs(knobs)
+		i.storage.AddKnobSample(model.KnobSample{TakenAt: time.Now(), Knobs: knobs})
+	}
+
+	groups := i.dueGroups(time.Now())
+	if len(groups) == 0 {
+		return
+	}
+
+	// groups that failed are logged, the ones that succeeded are still stored
+	metrics, err := i.metrics.SampleAggregatedMetrics(ctx, groups)
+	if err != nil {
+		log.Println(fmt.Errorf("metrics.SampleAggregatedMetrics: %w", err))
+	}
+	if len(metrics) == 0 {
+		return
+	}
+	i.storage.AddMetricSample(model.MetricSample{TakenAt: time.Now(), Metrics: metrics})
+}
+
+// dueGroups returns groups due at now and schedules their next sampling.
+// Half a collect interval of slack keeps ticker jitter from delaying a group by a whole tick.
+func (i *Implementation) dueGroups(now time.Time) []model.MetricGroup {
+	var groups []model.MetricGroup
+	for _, schedule := range i.groups {
+		if schedule.next.Sub(now) > i.collectInterval/2 {
+			continue
+		}
+		groups = append(groups, schedule.group)
+		schedule.next = now.Add(schedule.interval)
+	}
+	return groups
+}
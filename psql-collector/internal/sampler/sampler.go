@@ -0,0 +1,138 @@
+package sampler
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"postgresHelper/internal/config"
+	"postgresHelper/internal/model"
+)
+
+const (
+	defaultSampleInterval = time.Second
+	defaultCapacity       = 3600
+)
+
+type Sampler interface {
+	Run(ctx context.Context)
+	Histogram(window time.Duration) model.WaitEventHistogram
+}
+
+type Collector interface {
+	CollectSessionWaits(ctx context.Context) ([]model.SessionWait, error)
+}
+
+// Implementation polls wait events of backends and keeps the latest samples in a ring buffer.
+type Implementation struct {
+	collect  Collector
+	interval time.Duration
+
+	mu      sync.Mutex
+	samples []model.WaitEventSample
+	// next is the position the next sample is written to
+	next int
+	full bool
+}
+
+func New(collect Collector, cfg config.WaitSampler) *Implementation {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultSampleInterval
+	}
+	capacity := cfg.Capacity
+	if capacity <= 0 {
+		capacity = defaultCapacity
+	}
+
+	return &Implementation{
+		collect:  collect,
+		interval: interval,
+		samples:  make([]model.WaitEventSample, capacity),
+	}
+}
+
+func (i *Implementation) Run(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(i.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sessions, err := i.collect.CollectSessionWaits(ctx)
+				if err != nil {
+					log.Println(err)
+					continue
+				}
+				i.add(model.WaitEventSample{TakenAt: time.Now(), Sessions: sessions})
+			}
+		}
+	}()
+}
+
+func (i *Implementation) add(sample model.WaitEventSample) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.samples[i.next] = sample
+	i.next = (i.next + 1) % len(i.samples)
+	if i.next == 0 {
+		i.full = true
+	}
+}
+
+// Histogram counts sessions per wait event over samples taken within window,
+// events are sorted by count in descending order.
+func (i *Implementation) Histogram(window time.Duration) model.WaitEventHistogram {
+	to := time.Now()
+	histogram := model.WaitEventHistogram{From: to.Add(-window), To: to}
+
+	type event struct{ waitEventType, waitEvent string }
+	counts := make(map[event]int64)
+
+	i.mu.Lock()
+	for _, sample := range i.buffered() {
+		if sample.TakenAt.Before(histogram.From) {
+			continue
+		}
+		histogram.Samples++
+		for _, session := range sample.Sessions {
+			histogram.Sessions++
+			counts[event{session.WaitEventType, session.WaitEvent}]++
+		}
+	}
+	i.mu.Unlock()
+
+	for e, count := range counts {
+		histogram.Events = append(histogram.Events, model.WaitEventCount{
+			WaitEventType: e.waitEventType,
+			WaitEvent:     e.waitEvent,
+			Count:         count,
+		})
+	}
+	slices.SortFunc(histogram.Events, func(a, b model.WaitEventCount) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		if a.WaitEventType != b.WaitEventType {
+			return cmp.Compare(a.WaitEventType, b.WaitEventType)
+		}
+		return cmp.Compare(a.WaitEvent, b.WaitEvent)
+	})
+
+	return histogram
+}
+
+// buffered returns stored samples, the caller must hold i.mu.
+func (i *Implementation) buffered() []model.WaitEventSample {
+	if !i.full {
+		return i.samples[:i.next]
+	}
+	return i.samples
+}
@@ -1,7 +1,10 @@
 package storage
 
 import (
+	"fmt"
 	"postgresHelper/internal/model"
+	"slices"
+	"time"
 )
 
 func (s *Storage) GetKnobs() []model.Knob {
@@ -14,3 +17,81 @@ func (s *Storage) GetKnobs() []model.Knob {
 
 	return s.knobs
 }
+
+func (s *Storage) GetKnobSnapshot(name string) (model.KnobSnapshot, error) {
+	if s == nil {
+		return model.KnobSnapshot{}, model.ErrSnapshotNotFound
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot, ok := s.snapshots[name]
+	if !ok {
+		return model.KnobSnapshot{}, fmt.Errorf("%w: %s", model.ErrSnapshotNotFound, name)
+	}
+	return snapshot, nil
+}
+
+// ListKnobSnapshots returns snapshots ordered by creation time.
+func (s *Storage) ListKnobSnapshots() []model.KnobSnapshot {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshots := make([]model.KnobSnapshot, 0, len(s.snapshots))
+	for _, snapshot := range s.snapshots {
+		snapshots = append(snapshots, snapshot)
+	}
+	slices.SortFunc(snapshots, func(a, b model.KnobSnapshot) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return snapshots
+}
+
+// ListMetricSamples returns samples taken in [from, to] ordered by time.
+func (s *Storage) ListMetricSamples(from, to time.Time) []model.MetricSample {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return samplesBetween(s.metricSamples, from, to, func(sample model.MetricSample) time.Time {
+		return sample.TakenAt
+	})
+}
+
+// ListKnobSamples returns samples taken in [from, to] ordered by time.
+func (s *Storage) ListKnobSamples(from, to time.Time) []model.KnobSample {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return samplesBetween(s.knobSamples, from, to, func(sample model.KnobSample) time.Time {
+		return sample.TakenAt
+	})
+}
+
+func samplesBetween[T any](samples []T, from, to time.Time, takenAt func(T) time.Time) []T {
+	start, _ := slices.BinarySearchFunc(samples, from, func(sample T, from time.Time) int {
+		return takenAt(sample).Compare(from)
+	})
+	end, found := slices.BinarySearchFunc(samples, to, func(sample T, to time.Time) int {
+		return takenAt(sample).Compare(to)
+	})
+	if found {
+		end++
+	}
+	if start >= end {
+		return nil
+	}
+	return slices.Clone(samples[start:end])
+}
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"fmt"
 	"postgresHelper/internal/model"
+	"time"
 )
 
 func (s *Storage) SetKnobs(knobs []model.Knob) {
@@ -14,3 +16,71 @@ func (s *Storage) SetKnobs(knobs []model.Knob) {
 		copy(s.knobs, knobs)
 	}
 }
+
+func (s *Storage) SaveKnobSnapshot(snapshot model.KnobSnapshot) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.snapshots[snapshot.Name]; ok {
+		return fmt.Errorf("%w: %s", model.ErrSnapshotAlreadyExists, snapshot.Name)
+	}
+
+	knobs := make([]model.Knob, len(snapshot.Knobs))
+	copy(knobs, snapshot.Knobs)
+	snapshot.Knobs = knobs
+
+	s.snapshots[snapshot.Name] = snapshot
+	err := writeSnapshots(s.snapshotsPath, s.snapshots)
+	if err != nil {
+		delete(s.snapshots, snapshot.Name)
+		return fmt.Errorf("writeSnapshots: %w", err)
+	}
+	return nil
+}
+
+// AddMetricSample appends a sample and drops samples past retention or capacity.
+func (s *Storage) AddMetricSample(sample model.MetricSample) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.metricSamples = append(s.metricSamples, sample)
+	s.metricSamples = trimSamples(s.metricSamples, sample.TakenAt.Add(-s.retention), s.capacity, func(sample model.MetricSample) time.Time {
+		return sample.TakenAt
+	})
+}
+
+// AddKnobSample appends a sample and drops samples past retention or capacity.
+func (s *Storage) AddKnobSample(sample model.KnobSample) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.knobSamples = append(s.knobSamples, sample)
+	s.knobSamples = trimSamples(s.knobSamples, sample.TakenAt.Add(-s.retention), s.capacity, func(sample model.KnobSample) time.Time {
+		return sample.TakenAt
+	})
+}
+
+// trimSamples drops samples taken before oldest and the oldest samples above capacity.
+// The remaining samples are copied so the dropped ones can be garbage collected.
+func trimSamples[T any](samples []T, oldest time.Time, capacity int, takenAt func(T) time.Time) []T {
+	start := max(0, len(samples)-capacity)
+	for start < len(samples) && takenAt(samples[start]).Before(oldest) {
+		start++
+	}
+	if start == 0 {
+		return samples
+	}
+	return append(make([]T, 0, capacity), samples[start:]...)
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"postgresHelper/internal/model"
+)
+
+// loadSnapshots reads snapshots saved by a previous run, a missing file means there are none yet.
+func loadSnapshots(path string) (map[string]model.KnobSnapshot, error) {
+	snapshots := make(map[string]model.KnobSnapshot)
+	if path == "" {
+		return snapshots, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return snapshots, nil
+		}
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	err = json.Unmarshal(data, &snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return snapshots, nil
+}
+
+// writeSnapshots replaces the snapshot file through a temporary file,
+// so a crash while writing does not lose snapshots saved before.
+func writeSnapshots(path string, snapshots map[string]model.KnobSnapshot) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(snapshots)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("os.CreateTemp: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("tmp.Write: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("os.Rename: %w", err)
+	}
+	return nil
+}
@@ -1,8 +1,16 @@
 package storage
 
 import (
+	"fmt"
+	"postgresHelper/internal/config"
 	"postgresHelper/internal/model"
 	"sync"
+	"time"
+)
+
+const (
+	defaultRetention = time.Hour
+	defaultCapacity  = 360
 )
 
 type Storager interface {
@@ -12,19 +20,54 @@ type Storager interface {
 
 type Setter interface {
 	SetKnobs(knobs []model.Knob)
+	SaveKnobSnapshot(snapshot model.KnobSnapshot) error
+	AddMetricSample(sample model.MetricSample)
+	AddKnobSample(sample model.KnobSample)
 }
 
 type Getter interface {
 	GetKnobs() []model.Knob
+	GetKnobSnapshot(name string) (model.KnobSnapshot, error)
+	ListKnobSnapshots() []model.KnobSnapshot
+	ListMetricSamples(from, to time.Time) []model.MetricSample
+	ListKnobSamples(from, to time.Time) []model.KnobSample
 }
 
 type Storage struct {
-	knobs []model.Knob
-	mu    sync.Mutex
+	knobs     []model.Knob
+	snapshots map[string]model.KnobSnapshot
+	mu        sync.Mutex
+
+	// snapshotsPath is the file snapshots are persisted to, empty keeps them in memory only
+	snapshotsPath string
+
+	// metricSamples and knobSamples are ordered by TakenAt
+	metricSamples []model.MetricSample
+	knobSamples   []model.KnobSample
+	retention     time.Duration
+	capacity      int
 }
 
-func New() *Storage {
-	return &Storage{
-		knobs: make([]model.Knob, 0),
+func New(cfg config.History, snapshotsCfg config.KnobSnapshots) (*Storage, error) {
+	retention := cfg.Retention
+	if retention <= 0 {
+		retention = defaultRetention
 	}
+	capacity := cfg.Capacity
+	if capacity <= 0 {
+		capacity = defaultCapacity
+	}
+
+	snapshots, err := loadSnapshots(snapshotsCfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("loadSnapshots: %w", err)
+	}
+
+	return &Storage{
+		knobs:         make([]model.Knob, 0),
+		snapshots:     snapshots,
+		snapshotsPath: snapshotsCfg.Path,
+		retention:     retention,
+		capacity:      capacity,
+	}, nil
 }
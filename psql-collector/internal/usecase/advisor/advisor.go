@@ -0,0 +1,258 @@
+package advisor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"postgresHelper/internal/autovacuum"
+	"postgresHelper/internal/model"
+)
+
+type Advisor interface {
+	RecommendTableOptions(ctx context.Context) ([]model.TableOptionsRecommendation, error)
+	SetTableOptions(ctx context.Context, tables []model.TableOptions) ([]model.TableOptionApplyResult, error)
+	RecommendIndexes(ctx context.Context) (model.IndexAdvice, error)
+}
+
+type Collector interface {
+	CollectTablesInfo(ctx context.Context) ([]model.TableStat, model.Scope, error)
+	CollectTablesBloat(ctx context.Context) ([]model.TableBloating, model.Scope, error)
+	CollectIndexes(ctx context.Context) ([]model.Index, error)
+	CollectIndexesBloat(ctx context.Context) ([]model.IndexBloating, model.Scope, error)
+	CollectStatsReset(ctx context.Context) (sql.Null[time.Time], error)
+	SetTableOptions(ctx context.Context, tables []model.TableOptions) ([]model.TableOptionApplyResult, error)
+}
+
+type Policy interface {
+	IsAllowed(name string) bool
+	Check(option model.TableOption) error
+}
+
+type Autovacuum interface {
+	IsEnabled(ctx context.Context) (bool, error)
+	ReadCurrentAutovacuumSettings(ctx context.Context) (autovacuum.VacuumStats, error)
+	ReadRelations(ctx context.Context, settings autovacuum.VacuumStats) ([]autovacuum.VacuumStatsEntry, error)
+}
+
+const (
+	// largeTableRows is the size from which the default scale factors let too many rows change
+	// between autovacuum runs.
+	largeTableRows = 1_000_000
+	// maxDeadRowsBeforeVacuum and maxChangedRowsBeforeAnalyze bound the rows a large table
+	// accumulates before autovacuum vacuums or analyzes it.
+	maxDeadRowsBeforeVacuum     = 100_000
+	maxChangedRowsBeforeAnalyze = 50_000
+	minScaleFactor              = 0.005
+
+	// hotTableRows is the size from which a lower fillfactor pays off for update-heavy tables.
+	hotTableRows      = 10_000
+	updateFillfactor  = 90
+	bloatedFillfactor = 80
+	bloatedPercent    = 30
+
+	// highDeadRowsRatio is the share of dead rows that autovacuum is considered to fall behind at.
+	highDeadRowsRatio = 0.2
+	minCostLimit      = 1000
+	// defaultCostLimit is vacuum_cost_limit used when autovacuum_vacuum_cost_limit is -1.
+	defaultCostLimit = 200
+)
+
+// scaleFactorSteps are proposed scale factors from the largest one.
+var scaleFactorSteps = []float64{0.05, 0.02, 0.01, minScaleFactor}
+
+type Implementation struct {
+	collector Collector
+	vacuum    Autovacuum
+	policy    Policy
+}
+
+func New(collector Collector, vacuum Autovacuum, policy Policy) *Implementation {
+	return &Implementation{
+		collector: collector,
+		vacuum:    vacuum,
+		policy:    policy,
+	}
+}
+
+// RecommendTableOptions proposes per-table autovacuum settings and fillfactor for tables the database
+// settings fit poorly: large tables where the default scale factors let dead rows pile up, update-heavy
+// tables that need free space for HOT updates, and tables where autovacuum falls behind or is disabled.
+// Tables are matched with pg_stat_user_tables and bloat estimates by oid.
+func (i *Implementation) RecommendTableOptions(ctx context.Context) ([]model.TableOptionsRecommendation, error) {
+	settings, err := i.vacuum.ReadCurrentAutovacuumSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("vacuum.ReadCurrentAutovacuumSettings: %w", err)
+	}
+	settings.AutovacuumEnabled, err = i.vacuum.IsEnabled(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("vacuum.IsEnabled: %w", err)
+	}
+
+	relations, err := i.vacuum.ReadRelations(ctx, settings)
+	if err != nil {
+		return nil, fmt.Errorf("vacuum.ReadRelations: %w", err)
+	}
+
+	tableStats, _, err := i.collector.CollectTablesInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collector.CollectTablesInfo: %w", err)
+	}
+	statsByID := make(map[int64]model.TableStat, len(tableStats))
+	for _, stat := range tableStats {
+		statsByID[stat.RelationID] = stat
+	}
+
+	bloat, _, err := i.collector.CollectTablesBloat(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collector.CollectTablesBloat: %w", err)
+	}
+	bloatByID := make(map[int64]model.TableBloating, len(bloat))
+	for _, table := range bloat {
+		bloatByID[table.RelationID] = table
+	}
+
+	var recommendations []model.TableOptionsRecommendation
+	for _, relation := range relations {
+		options := recommendOptions(relation, statsByID[relation.RelationID], bloatByID[relation.RelationID], settings)
+		options = slices.DeleteFunc(options, func(option model.TableOptionRecommendation) bool {
+			return !i.policy.IsAllowed(option.Name)
+		})
+		if len(options) == 0 {
+			continue
+		}
+		recommendations = append(recommendations, model.TableOptionsRecommendation{
+			SchemaName:   relation.SchemaName,
+			RelationName: relation.RelationName,
+			Options:      options,
+		})
+	}
+	return recommendations, nil
+}
+
+func recommendOptions(relation autovacuum.VacuumStatsEntry, stat model.TableStat, bloat model.TableBloating, settings autovacuum.VacuumStats) []model.TableOptionRecommendation {
+	var options []model.TableOptionRecommendation
+
+	live := float64(relation.LiveRowCount)
+	changes := float64(stat.NumberOfUpdates + stat.NumberOfDeletes)
+	updateHeavy := live > 0 && float64(stat.NumberOfUpdates) >= live && stat.NumberOfUpdates >= 2*stat.NumberOfInserts
+
+	var deadRatio float64
+	if total := relation.LiveRowCount + relation.DeadRowCount; total > 0 {
+		deadRatio = float64(relation.DeadRowCount) / float64(total)
+	}
+
+	if !relation.AutovacuumEnabled && settings.AutovacuumEnabled && deadRatio >= highDeadRowsRatio {
+		options = append(options, model.TableOptionRecommendation{
+			Name:         "autovacuum_enabled",
+			Value:        "true",
+			CurrentValue: "false",
+			Rationale:    fmt.Sprintf("autovacuum is disabled for the table and %.0f%% of its rows are dead", deadRatio*100),
+		})
+	}
+
+	if live >= largeTableRows && (changes >= live || deadRatio >= highDeadRowsRatio/2) {
+		currentDeadRows := float64(relation.AutovacuumVacuumThreshold) + live*relation.AutovacuumVacuumScaleFactor
+		scaleFactor, ok := scaleFactorFor(live, maxDeadRowsBeforeVacuum)
+		proposedDeadRows := float64(relation.AutovacuumVacuumThreshold) + live*scaleFactor
+		if !ok {
+			// even the smallest scale factor lets too many rows die, vacuum after a fixed number of them
+			scaleFactor = 0
+			proposedDeadRows = maxDeadRowsBeforeVacuum
+		}
+		if scaleFactor < relation.AutovacuumVacuumScaleFactor {
+			options = append(options, model.TableOptionRecommendation{
+				Name:         "autovacuum_vacuum_scale_factor",
+				Value:        formatNumber(scaleFactor),
+				CurrentValue: formatNumber(relation.AutovacuumVacuumScaleFactor),
+				Rationale: fmt.Sprintf("with %.0f live rows the current settings let %.0f dead rows accumulate before autovacuum, the proposed ones about %.0f",
+					live, currentDeadRows, proposedDeadRows),
+			})
+		}
+		if !ok && relation.AutovacuumVacuumThreshold != maxDeadRowsBeforeVacuum {
+			options = append(options, model.TableOptionRecommendation{
+				Name:         "autovacuum_vacuum_threshold",
+				Value:        strconv.Itoa(maxDeadRowsBeforeVacuum),
+				CurrentValue: strconv.Itoa(int(relation.AutovacuumVacuumThreshold)),
+				Rationale:    fmt.Sprintf("with the scale factor of 0 autovacuum runs every %d dead rows regardless of the table size", maxDeadRowsBeforeVacuum),
+			})
+		}
+
+		analyzeScaleFactor, _ := scaleFactorFor(live, maxChangedRowsBeforeAnalyze)
+		if analyzeScaleFactor < relation.AutovacuumAnalyzeScaleFactor {
+			options = append(options, model.TableOptionRecommendation{
+				Name:         "autovacuum_analyze_scale_factor",
+				Value:        formatNumber(analyzeScaleFactor),
+				CurrentValue: formatNumber(relation.AutovacuumAnalyzeScaleFactor),
+				Rationale: fmt.Sprintf("statistics of a table with %.0f live rows get stale after %.0f changes with the current scale factor",
+					live, live*relation.AutovacuumAnalyzeScaleFactor),
+			})
+		}
+
+		costLimit := relation.AutovacuumVacuumCostLimit
+		if costLimit <= 0 {
+			costLimit = defaultCostLimit
+		}
+		if deadRatio >= highDeadRowsRatio && relation.LastAutoVacuumRun.Valid && costLimit < minCostLimit {
+			options = append(options, model.TableOptionRecommendation{
+				Name:         "autovacuum_vacuum_cost_limit",
+				Value:        strconv.Itoa(minCostLimit),
+				CurrentValue: strconv.Itoa(int(costLimit)),
+				Rationale:    fmt.Sprintf("autovacuum runs on the table but %.0f%% of its rows are dead, it is likely throttled by the cost limit", deadRatio*100),
+			})
+		}
+	}
+
+	if updateHeavy && live >= hotTableRows && relation.Fillfactor == 100 {
+		fillfactor := updateFillfactor
+		rationale := fmt.Sprintf("the table had %d updates with %.0f live rows, free space in pages allows HOT updates that do not touch indexes", stat.NumberOfUpdates, live)
+		if bloat.TableBloatInPercent >= bloatedPercent {
+			fillfactor = bloatedFillfactor
+			rationale += fmt.Sprintf(", the table is %.0f%% bloated", bloat.TableBloatInPercent)
+		}
+		options = append(options, model.TableOptionRecommendation{
+			Name:         "fillfactor",
+			Value:        strconv.Itoa(fillfactor),
+			CurrentValue: strconv.Itoa(int(relation.Fillfactor)),
+			Rationale:    rationale + "; it applies to new pages, existing ones are repacked by VACUUM FULL or pg_repack",
+		})
+	}
+
+	return options
+}
+
+// scaleFactorFor returns the largest step that keeps rows*scaleFactor under maxRows,
+// or the smallest step and false when there is no such step.
+func scaleFactorFor(rows, maxRows float64) (float64, bool) {
+	for _, step := range scaleFactorSteps {
+		if rows*step <= maxRows {
+			return step, true
+		}
+	}
+	return minScaleFactor, false
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// SetTableOptions applies storage parameters, all tables are changed or none of them.
+// The whole request is refused when any parameter violates the policy.
+func (i *Implementation) SetTableOptions(ctx context.Context, tables []model.TableOptions) ([]model.TableOptionApplyResult, error) {
+	for _, table := range tables {
+		for _, option := range table.Options {
+			if err := i.policy.Check(option); err != nil {
+				return nil, fmt.Errorf("policy.Check: %w", err)
+			}
+		}
+	}
+
+	results, err := i.collector.SetTableOptions(ctx, tables)
+	if err != nil {
+		return nil, fmt.Errorf("collector.SetTableOptions: %w", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,200 @@
+package advisor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"postgresHelper/internal/model"
+)
+
+const (
+	// bloatedIndexPercent and bloatedIndexMegabytes are the bloat an index is worth rebuilding at.
+	bloatedIndexPercent   = 30
+	bloatedIndexMegabytes = 10
+
+	bytesInMegabyte = 1024 * 1024
+)
+
+// RecommendIndexes finds invalid indexes left by failed concurrent builds, indexes covered by
+// or duplicating another index of the table, indexes never scanned since statistics were reset,
+// and bloated indexes. Every index gets at most one recommendation in this order.
+// Unique indexes and indexes constraints depend on are never proposed for dropping.
+func (i *Implementation) RecommendIndexes(ctx context.Context) (model.IndexAdvice, error) {
+	indexes, err := i.collector.CollectIndexes(ctx)
+	if err != nil {
+		return model.IndexAdvice{}, fmt.Errorf("collector.CollectIndexes: %w", err)
+	}
+
+	statsReset, err := i.collector.CollectStatsReset(ctx)
+	if err != nil {
+		return model.IndexAdvice{}, fmt.Errorf("collector.CollectStatsReset: %w", err)
+	}
+
+	bloat, _, err := i.collector.CollectIndexesBloat(ctx)
+	if err != nil {
+		return model.IndexAdvice{}, fmt.Errorf("collector.CollectIndexesBloat: %w", err)
+	}
+
+	advice := model.IndexAdvice{StatsReset: statsReset}
+	recommended := make(map[string]struct{})
+	add := func(recommendation model.IndexRecommendation) {
+		recommended[model.QualifiedName(recommendation.SchemaName, recommendation.IndexName)] = struct{}{}
+		advice.Recommendations = append(advice.Recommendations, recommendation)
+	}
+	isRecommended := func(index model.Index) bool {
+		_, ok := recommended[model.QualifiedName(index.SchemaName, index.IndexName)]
+		return ok
+	}
+
+	for _, index := range indexes {
+		if !index.Valid {
+			add(model.IndexRecommendation{
+				Kind:       model.IndexInvalid,
+				SchemaName: index.SchemaName,
+				TableName:  index.TableName,
+				IndexName:  index.IndexName,
+				Reason:     "the index is invalid, most likely after a failed CREATE INDEX CONCURRENTLY, it is maintained on writes but never used by queries; rebuild it or drop it if it is not needed",
+				Statement:  "REINDEX INDEX CONCURRENTLY " + model.QualifiedName(index.SchemaName, index.IndexName),
+			})
+		}
+	}
+
+	// the widest covering index is cited, it is not covered by another one and is kept
+	for _, index := range indexes {
+		if !canDrop(index) {
+			continue
+		}
+		var covering *model.Index
+		for otherIdx, other := range indexes {
+			if other.Valid && sameTable(index, other) && coversPrefix(other, index) &&
+				(covering == nil || len(other.Columns) > len(covering.Columns)) {
+				covering = &indexes[otherIdx]
+			}
+		}
+		if covering != nil {
+			add(dropRecommendation(model.IndexOverlapping, index, covering.IndexName,
+				fmt.Sprintf("columns of the index are a leading part of %s that serves the same queries", covering.IndexName)))
+		}
+	}
+
+	for idx, index := range indexes {
+		if isRecommended(index) || !canDrop(index) {
+			continue
+		}
+		for otherIdx, other := range indexes {
+			if otherIdx == idx || !other.Valid || isRecommended(other) || !sameTable(index, other) {
+				continue
+			}
+			if isDuplicate(index, other) && keepsOver(other, index) {
+				add(dropRecommendation(model.IndexDuplicate, index, other.IndexName,
+					fmt.Sprintf("the index has the same definition as %s", other.IndexName)))
+				break
+			}
+		}
+	}
+
+	unusedReason := "the index was never scanned, statistics were never reset; scans on replicas are not counted"
+	if statsReset.Valid {
+		unusedReason = fmt.Sprintf("the index was never scanned since statistics were reset at %s; scans on replicas are not counted",
+			statsReset.V.Format("2006-01-02 15:04:05 MST"))
+	}
+	for _, index := range indexes {
+		if isRecommended(index) || !canDrop(index) || index.Scans > 0 {
+			continue
+		}
+		add(dropRecommendation(model.IndexUnused, index, "", unusedReason))
+	}
+
+	for _, stat := range bloat {
+		if stat.IndexBloatInPercent < bloatedIndexPercent || stat.IndexBloatInMegabytes < bloatedIndexMegabytes {
+			continue
+		}
+		idx := slices.IndexFunc(indexes, func(index model.Index) bool {
+			return index.ID == stat.IndexID
+		})
+		if idx == -1 || isRecommended(indexes[idx]) {
+			continue
+		}
+		index := indexes[idx]
+		add(model.IndexRecommendation{
+			Kind:         model.IndexBloated,
+			SchemaName:   index.SchemaName,
+			TableName:    index.TableName,
+			IndexName:    index.IndexName,
+			Reason:       fmt.Sprintf("the index is %.0f%% bloated, rebuilding it frees about %.0f MB", stat.IndexBloatInPercent, stat.IndexBloatInMegabytes),
+			SavingsBytes: stat.IndexBloatInMegabytes * bytesInMegabyte,
+			Statement:    "REINDEX INDEX CONCURRENTLY " + model.QualifiedName(index.SchemaName, index.IndexName),
+		})
+	}
+
+	return advice, nil
+}
+
+func dropRecommendation(kind model.IndexRecommendationKind, index model.Index, related, reason string) model.IndexRecommendation {
+	return model.IndexRecommendation{
+		Kind:         kind,
+		SchemaName:   index.SchemaName,
+		TableName:    index.TableName,
+		IndexName:    index.IndexName,
+		RelatedIndex: related,
+		Reason:       reason,
+		SavingsBytes: index.SizeBytes,
+		Statement:    "DROP INDEX CONCURRENTLY " + model.QualifiedName(index.SchemaName, index.IndexName),
+	}
+}
+
+// canDrop reports whether the index may be proposed for dropping: unique indexes enforce
+// constraints even when they are never scanned.
+func canDrop(index model.Index) bool {
+	return index.Valid && !index.Unique && !index.Primary && !index.Constraint
+}
+
+func sameTable(a, b model.Index) bool {
+	return a.SchemaName == b.SchemaName && a.TableName == b.TableName
+}
+
+func isDuplicate(a, b model.Index) bool {
+	return a.AccessMethod == b.AccessMethod &&
+		slices.Equal(a.Columns, b.Columns) &&
+		slices.Equal(a.OperatorClasses, b.OperatorClasses) &&
+		slices.Equal(a.Collations, b.Collations) &&
+		slices.Equal(a.Options, b.Options) &&
+		a.Expressions == b.Expressions &&
+		a.Predicate == b.Predicate
+}
+
+// keepsOver reports whether of two duplicates a is kept and b is dropped:
+// the one a constraint may depend on is kept, then the more used one, then the first by name.
+func keepsOver(a, b model.Index) bool {
+	if !canDrop(a) {
+		return true
+	}
+	if a.Scans != b.Scans {
+		return a.Scans > b.Scans
+	}
+	return a.IndexName < b.IndexName
+}
+
+// coversPrefix reports whether btree index a has more columns than b and its key columns start
+// with all columns of b with the same operator classes, collations and sort options, so b serves
+// no query a does not. Operator classes cover key columns only, columns past them are INCLUDE columns.
+func coversPrefix(a, b model.Index) bool {
+	if a.AccessMethod != "btree" || b.AccessMethod != "btree" || a.Predicate != b.Predicate {
+		return false
+	}
+	if a.Expressions != "" || b.Expressions != "" || len(a.Columns) <= len(b.Columns) {
+		return false
+	}
+	keys := len(b.Columns)
+	if len(b.OperatorClasses) != keys || len(a.OperatorClasses) < keys {
+		return false
+	}
+	if len(b.Collations) != keys || len(a.Collations) < keys || len(b.Options) != keys || len(a.Options) < keys {
+		return false
+	}
+	return slices.Equal(a.Columns[:keys], b.Columns) &&
+		slices.Equal(a.OperatorClasses[:keys], b.OperatorClasses) &&
+		slices.Equal(a.Collations[:keys], b.Collations) &&
+		slices.Equal(a.Options[:keys], b.Options)
+}
@@ -0,0 +1,300 @@
+package advisor
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+func TestCoversPrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b model.Index
+		want bool
+	}{
+		{name: "leading columns", a: btree("t_a_b", 1, 2), b: btree("t_a", 1), want: true},
+		{name: "same columns", a: btree("t_a_2", 1), b: btree("t_a", 1), want: false},
+		{name: "other leading column", a: btree("t_b_a", 2, 1), b: btree("t_a", 1), want: false},
+		{name: "other operator class", a: btree("t_a_b", 1, 2), b: withOperatorClasses(btree("t_a", 1), "3128"), want: false},
+		{name: "other collation", a: btree("t_a_b", 1, 2), b: withCollations(btree("t_a", 1), "950"), want: false},
+		{name: "descending column", a: btree("t_a_b", 1, 2), b: withOptions(btree("t_a", 1), "3"), want: false},
+		{name: "same sort options", a: withOptions(btree("t_a_b", 1, 2), "3", "0"), b: withOptions(btree("t_a", 1), "3"), want: true},
+		{name: "covering index with INCLUDE columns", a: withInclude(btree("t_a_incl", 1), 2), b: btree("t_a", 1), want: true},
+		{name: "INCLUDE column is not a key column", a: withInclude(btree("t_a_incl", 1), 2, 3), b: btree("t_a_b", 1, 2), want: false},
+		{name: "covered index with INCLUDE columns", a: btree("t_a_b_c", 1, 2, 3), b: withInclude(btree("t_a_incl", 1), 2), want: false},
+		{name: "different predicate", a: btree("t_a_b", 1, 2), b: withPredicate(btree("t_a", 1), "(a > 0)"), want: false},
+		{name: "expression", a: btree("t_a_b", 1, 2), b: withExpressions(btree("t_lower_a", 0), "lower(a)"), want: false},
+		{name: "not btree", a: btree("t_a_b", 1, 2), b: withAccessMethod(btree("t_a", 1), "hash"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := coversPrefix(tt.a, tt.b); got != tt.want {
+				t.Errorf("coversPrefix(%s, %s) = %v, want %v", tt.a.IndexName, tt.b.IndexName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsDuplicate(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b model.Index
+		want bool
+	}{
+		{name: "same definition", a: btree("t_a", 1, 2), b: btree("t_a_2", 1, 2), want: true},
+		{name: "other column order", a: btree("t_a_b", 1, 2), b: btree("t_b_a", 2, 1), want: false},
+		{name: "other operator class", a: btree("t_a", 1), b: withOperatorClasses(btree("t_a_2", 1), "3128"), want: false},
+		{name: "other collation", a: btree("t_a", 1), b: withCollations(btree("t_a_2", 1), "950"), want: false},
+		{name: "other sort options", a: btree("t_a", 1, 2), b: withOptions(btree("t_a_2", 1, 2), "0", "3"), want: false},
+		{name: "other predicate", a: btree("t_a", 1), b: withPredicate(btree("t_a_2", 1), "(a > 0)"), want: false},
+		{name: "other access method", a: btree("t_a", 1), b: withAccessMethod(btree("t_a_2", 1), "hash"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDuplicate(tt.a, tt.b); got != tt.want {
+				t.Errorf("isDuplicate(%s, %s) = %v, want %v", tt.a.IndexName, tt.b.IndexName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeepsOver(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b model.Index
+		want bool
+	}{
+		{name: "constraint index is kept", a: withConstraint(btree("t_b", 1)), b: withScans(btree("t_a", 1), 100), want: true},
+		{name: "unique index is kept", a: withUnique(btree("t_b", 1)), b: withScans(btree("t_a", 1), 100), want: true},
+		{name: "more scanned index is kept", a: withScans(btree("t_b", 1), 10), b: withScans(btree("t_a", 1), 5), want: true},
+		{name: "less scanned index is dropped", a: withScans(btree("t_a", 1), 5), b: withScans(btree("t_b", 1), 10), want: false},
+		{name: "first by name is kept on a tie", a: btree("t_a", 1), b: btree("t_b", 1), want: true},
+		{name: "second by name is dropped on a tie", a: btree("t_b", 1), b: btree("t_a", 1), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keepsOver(tt.a, tt.b); got != tt.want {
+				t.Errorf("keepsOver(%s, %s) = %v, want %v", tt.a.IndexName, tt.b.IndexName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendIndexes(t *testing.T) {
+	tests := []struct {
+		name    string
+		indexes []model.Index
+		bloat   []model.IndexBloating
+		want    map[string]model.IndexRecommendationKind
+	}{
+		{
+			name: "unique, primary and constraint indexes are never dropped",
+			indexes: []model.Index{
+				withScans(withUnique(btree("t_a_key", 1)), 0),
+				withScans(withPrimary(btree("t_pkey", 2)), 0),
+				withScans(withConstraint(btree("t_b_excl", 3)), 0),
+				withUnique(btree("t_a_key_2", 1)),
+				withUnique(btree("t_c_key", 4)),
+				withUnique(btree("t_c_d_key", 4, 5)),
+			},
+			want: map[string]model.IndexRecommendationKind{},
+		},
+		{
+			name:    "duplicate with fewer scans is dropped",
+			indexes: []model.Index{withScans(btree("t_a", 1), 1), withScans(btree("t_a_2", 1), 10)},
+			want:    map[string]model.IndexRecommendationKind{"t_a": model.IndexDuplicate},
+		},
+		{
+			name:    "duplicates tie on scans, the first by name is kept",
+			indexes: []model.Index{btree("t_b", 1), btree("t_a", 1)},
+			want:    map[string]model.IndexRecommendationKind{"t_b": model.IndexDuplicate},
+		},
+		{
+			name:    "duplicate of a unique index is dropped",
+			indexes: []model.Index{withScans(withUnique(btree("t_a_key", 1)), 0), withScans(btree("t_a", 1), 10)},
+			want:    map[string]model.IndexRecommendationKind{"t_a": model.IndexDuplicate},
+		},
+		{
+			name:    "descending duplicate is kept",
+			indexes: []model.Index{btree("t_a", 1), withOptions(btree("t_a_desc", 1), "3")},
+			want:    map[string]model.IndexRecommendationKind{},
+		},
+		{
+			name:    "unused covered index is only reported as covered",
+			indexes: []model.Index{withScans(btree("t_a", 1), 0), btree("t_a_b", 1, 2)},
+			want:    map[string]model.IndexRecommendationKind{"t_a": model.IndexOverlapping},
+		},
+		{
+			name:    "index covered under another collation is kept",
+			indexes: []model.Index{withCollations(btree("t_a_c", 1), "950"), btree("t_a_b", 1, 2)},
+			want:    map[string]model.IndexRecommendationKind{},
+		},
+		{
+			name: "indexes of other tables are not compared",
+			indexes: []model.Index{
+				btree("t_a", 1),
+				func() model.Index { index := btree("s_a", 1); index.TableName = "s"; return index }(),
+			},
+			want: map[string]model.IndexRecommendationKind{},
+		},
+		{
+			name:    "unused index",
+			indexes: []model.Index{withScans(btree("t_a", 1), 0)},
+			want:    map[string]model.IndexRecommendationKind{"t_a": model.IndexUnused},
+		},
+		{
+			name: "invalid index is only reported as invalid",
+			indexes: []model.Index{
+				func() model.Index { index := withScans(btree("t_a_ccnew", 1), 0); index.Valid = false; return index }(),
+				btree("t_a", 1),
+			},
+			bloat: []model.IndexBloating{{IndexID: 1, IndexBloatInPercent: 90, IndexBloatInMegabytes: 100}},
+			want:  map[string]model.IndexRecommendationKind{"t_a_ccnew": model.IndexInvalid},
+		},
+		{
+			name:    "bloated index",
+			indexes: []model.Index{btree("t_a", 1)},
+			bloat:   []model.IndexBloating{{IndexID: 1, IndexBloatInPercent: 50, IndexBloatInMegabytes: 20}},
+			want:    map[string]model.IndexRecommendationKind{"t_a": model.IndexBloated},
+		},
+		{
+			name:    "slightly bloated index",
+			indexes: []model.Index{btree("t_a", 1)},
+			bloat:   []model.IndexBloating{{IndexID: 1, IndexBloatInPercent: 50, IndexBloatInMegabytes: 5}},
+			want:    map[string]model.IndexRecommendationKind{},
+		},
+		{
+			name:    "unused bloated index is only reported as unused",
+			indexes: []model.Index{withScans(btree("t_a", 1), 0)},
+			bloat:   []model.IndexBloating{{IndexID: 1, IndexBloatInPercent: 50, IndexBloatInMegabytes: 20}},
+			want:    map[string]model.IndexRecommendationKind{"t_a": model.IndexUnused},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for idx := range tt.indexes {
+				tt.indexes[idx].ID = int64(idx + 1)
+			}
+			advisor := New(&indexCollector{indexes: tt.indexes, bloat: tt.bloat}, nil, nil)
+
+			advice, err := advisor.RecommendIndexes(context.Background())
+			if err != nil {
+				t.Fatalf("RecommendIndexes() error = %v", err)
+			}
+
+			got := make(map[string]model.IndexRecommendationKind)
+			for _, recommendation := range advice.Recommendations {
+				if _, ok := got[recommendation.IndexName]; ok {
+					t.Errorf("RecommendIndexes() recommended %s twice", recommendation.IndexName)
+				}
+				got[recommendation.IndexName] = recommendation.Kind
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RecommendIndexes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// indexCollector serves index fixtures to RecommendIndexes.
+type indexCollector struct {
+	Collector
+	indexes []model.Index
+	bloat   []model.IndexBloating
+}
+
+func (c *indexCollector) CollectIndexes(context.Context) ([]model.Index, error) {
+	return c.indexes, nil
+}
+
+func (c *indexCollector) CollectIndexesBloat(context.Context) ([]model.IndexBloating, model.Scope, error) {
+	return c.bloat, model.General, nil
+}
+
+func (c *indexCollector) CollectStatsReset(context.Context) (sql.Null[time.Time], error) {
+	return sql.Null[time.Time]{}, nil
+}
+
+// btree is a valid, scanned btree index of public.t on key columns with default
+// operator classes, collations and sort options.
+func btree(name string, columns ...int) model.Index {
+	index := model.Index{
+		SchemaName:   "public",
+		TableName:    "t",
+		IndexName:    name,
+		AccessMethod: "btree",
+		Columns:      columns,
+		Valid:        true,
+		Scans:        1,
+	}
+	for range columns {
+		index.OperatorClasses = append(index.OperatorClasses, "1978")
+		index.Collations = append(index.Collations, "0")
+		index.Options = append(index.Options, "0")
+	}
+	return index
+}
+
+func withInclude(index model.Index, columns ...int) model.Index {
+	index.Columns = append(index.Columns, columns...)
+	return index
+}
+
+func withOperatorClasses(index model.Index, operatorClasses ...string) model.Index {
+	index.OperatorClasses = operatorClasses
+	return index
+}
+
+func withCollations(index model.Index, collations ...string) model.Index {
+	index.Collations = collations
+	return index
+}
+
+func withOptions(index model.Index, options ...string) model.Index {
+	index.Options = options
+	return index
+}
+
+func withPredicate(index model.Index, predicate string) model.Index {
+	index.Predicate = predicate
+	return index
+}
+
+func withExpressions(index model.Index, expressions string) model.Index {
+	index.Expressions = expressions
+	return index
+}
+
+func withAccessMethod(index model.Index, accessMethod string) model.Index {
+	index.AccessMethod = accessMethod
+	return index
+}
+
+func withScans(index model.Index, scans int64) model.Index {
+	index.Scans = scans
+	return index
+}
+
+func withUnique(index model.Index) model.Index {
+	index.Unique = true
+	return index
+}
+
+func withPrimary(index model.Index) model.Index {
+	index.Unique = true
+	index.Primary = true
+	return index
+}
+
+func withConstraint(index model.Index) model.Index {
+	index.Constraint = true
+	return index
+}
@@ -3,27 +3,33 @@ package loader
 import (
 	"context"
 	"postgresHelper/internal/model"
+	"sync"
+	"time"
 )
 
 type Bench interface {
 	InitializePgbench(ctx context.Context) error
-	RunPgbench(ctx context.Context) (float64, float64, error)
+	RunPgbench(ctx context.Context, workload model.Workload) (model.ExternalMetric, error)
 }
 
 type Loader interface {
-	RunLoad(ctx context.Context) (<-chan model.ExternalMetric, <-chan error)
+	RunLoad(ctx context.Context, workload model.Workload) (<-chan model.ExternalMetric, <-chan error)
 	InitLoad(ctx context.Context) error
 }
 
 type Implementation struct {
 	bench Bench
+
+	mu         sync.Mutex
+	last       model.ExternalMetric
+	lastLoadAt time.Time
 }
 
 func New(bench Bench) *Implementation {
 	return &Implementation{bench: bench}
 }
 
-func (i *Implementation) RunLoad(ctx context.Context) (<-chan model.ExternalMetric, <-chan error) {
+func (i *Implementation) RunLoad(ctx context.Context, workload model.Workload) (<-chan model.ExternalMetric, <-chan error) {
 	metricCh, errCh := make(chan model.ExternalMetric), make(chan error)
 	go func() {
 		defer func() {
@@ -31,14 +37,13 @@ func (i *Implementation) RunLoad(ctx context.Context) (<-chan model.ExternalMetr
 			close(errCh)
 		}()
 
-		tps, latency, err := i.bench.RunPgbench(ctx)
+		metric, err := i.bench.RunPgbench(ctx, workload)
 		if err != nil {
 			errCh <- err
+			return
 		}
-		metricCh <- model.ExternalMetric{
-			Tps:     tps,
-			Latency: latency,
-		}
+		i.setLast(metric)
+		metricCh <- metric
 	}()
 
 	return metricCh, errCh
@@ -48,3 +53,18 @@ func (i *Implementation) RunLoad(ctx context.Context) (<-chan model.ExternalMetr
 func (i *Implementation) InitLoad(ctx context.Context) error {
 	return i.bench.InitializePgbench(ctx)
 }
+
+// LastLoad returns metrics of the latest successful load and when it finished.
+func (i *Implementation) LastLoad() (model.ExternalMetric, time.Time, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.last, i.lastLoadAt, !i.lastLoadAt.IsZero()
+}
+
+func (i *Implementation) setLast(metric model.ExternalMetric) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.last, i.lastLoadAt = metric, time.Now()
+}
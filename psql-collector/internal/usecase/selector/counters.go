@@ -0,0 +1,115 @@
+package selector
+
+import (
+	"sync"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+// counterBaselineTTL is how long the sample of a client that stopped calling is kept.
+const counterBaselineTTL = time.Hour
+
+// counterSample is the latest value of every counter metric taken for a client.
+type counterSample struct {
+	values  map[string]float64
+	takenAt time.Time
+}
+
+// counterSamples keeps the latest counter sample of every client between calls,
+// so deltas of one client do not depend on calls of others.
+type counterSamples struct {
+	mu      sync.Mutex
+	clients map[string]counterSample
+}
+
+func newCounterSamples() *counterSamples {
+	return &counterSamples{clients: make(map[string]counterSample)}
+}
+
+func (c *counterSamples) empty(client string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.clients[client].values) == 0
+}
+
+// swap stores counters from metrics as the latest sample of client and returns the sample it replaced.
+// Samples of clients that have not called for counterBaselineTTL are dropped.
+func (c *counterSamples) swap(client string, metrics []model.InternalMetric, takenAt time.Time) (map[string]float64, time.Time) {
+	values := counterValues(metrics)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous := c.clients[client]
+	c.clients[client] = counterSample{values: values, takenAt: takenAt}
+	for name, sample := range c.clients {
+		if takenAt.Sub(sample.takenAt) > counterBaselineTTL {
+			delete(c.clients, name)
+		}
+	}
+	return previous.values, previous.takenAt
+}
+
+func counterValues(metrics []model.InternalMetric) map[string]float64 {
+	values := make(map[string]float64)
+	for _, metric := range metrics {
+		if value, ok := counterValue(metric); ok {
+			values[counterKey(metric)] = value
+		}
+	}
+	return values
+}
+
+func counterValue(metric model.InternalMetric) (float64, bool) {
+	if !metric.Counter {
+		return 0, false
+	}
+
+	switch v := metric.Value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func counterKey(metric model.InternalMetric) string {
+	return metric.Scope.String() + "/" + metric.Name
+}
+
+// toCounterDeltas replaces counter values with their increase since the previous sample,
+// or with the increase per second in rate mode. A counter that went backwards was reset,
+// its whole current value is counted as the increase and the metric is flagged.
+// Counters without a previous sample and non-counter metrics are left as they are.
+func toCounterDeltas(metrics []model.InternalMetric, previous map[string]float64, interval time.Duration, mode model.MetricsMode) []model.InternalMetric {
+	converted := make([]model.InternalMetric, 0, len(metrics))
+	for _, metric := range metrics {
+		value, ok := counterValue(metric)
+		previousValue, found := previous[counterKey(metric)]
+		if !ok || !found {
+			converted = append(converted, metric)
+			continue
+		}
+
+		delta := value - previousValue
+		if delta < 0 {
+			delta = value
+			metric.CounterReset = true
+		}
+
+		if mode == model.MetricsModeRate {
+			if interval > 0 {
+				delta /= interval.Seconds()
+			} else {
+				delta = 0
+			}
+		}
+		metric.Value = delta
+		converted = append(converted, metric)
+	}
+	return converted
+}
@@ -0,0 +1,204 @@
+package selector
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+func TestToCounterDeltas(t *testing.T) {
+	tests := []struct {
+		name     string
+		metrics  []model.InternalMetric
+		previous map[string]float64
+		interval time.Duration
+		mode     model.MetricsMode
+		want     []model.InternalMetric
+	}{
+		{
+			name:     "first sample without baseline",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(100))},
+			interval: time.Second,
+			mode:     model.MetricsModeDelta,
+			want:     []model.InternalMetric{counter("xact_commit", int64(100))},
+		},
+		{
+			name:     "counter missing from baseline",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(100))},
+			previous: map[string]float64{"general/xact_rollback": 10},
+			interval: time.Second,
+			mode:     model.MetricsModeDelta,
+			want:     []model.InternalMetric{counter("xact_commit", int64(100))},
+		},
+		{
+			name:     "delta",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(150)), gauge("numbackends", int64(5))},
+			previous: map[string]float64{"general/xact_commit": 100, "general/numbackends": 3},
+			interval: 10 * time.Second,
+			mode:     model.MetricsModeDelta,
+			want:     []model.InternalMetric{counter("xact_commit", 50.), gauge("numbackends", int64(5))},
+		},
+		{
+			name:     "baseline of another scope",
+			metrics:  []model.InternalMetric{tableCounter("seq_scan", 40.)},
+			previous: map[string]float64{"general/seq_scan": 10},
+			interval: time.Second,
+			mode:     model.MetricsModeDelta,
+			want:     []model.InternalMetric{tableCounter("seq_scan", 40.)},
+		},
+		{
+			name:     "counter reset",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(30))},
+			previous: map[string]float64{"general/xact_commit": 100},
+			interval: 10 * time.Second,
+			mode:     model.MetricsModeDelta,
+			want:     []model.InternalMetric{reset(counter("xact_commit", 30.))},
+		},
+		{
+			name:     "rate",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(150))},
+			previous: map[string]float64{"general/xact_commit": 100},
+			interval: 10 * time.Second,
+			mode:     model.MetricsModeRate,
+			want:     []model.InternalMetric{counter("xact_commit", 5.)},
+		},
+		{
+			name:     "rate over short interval",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(150))},
+			previous: map[string]float64{"general/xact_commit": 100},
+			interval: 250 * time.Millisecond,
+			mode:     model.MetricsModeRate,
+			want:     []model.InternalMetric{counter("xact_commit", 200.)},
+		},
+		{
+			name:     "rate over zero interval",
+			metrics:  []model.InternalMetric{counter("xact_commit", int64(150))},
+			previous: map[string]float64{"general/xact_commit": 100},
+			mode:     model.MetricsModeRate,
+			want:     []model.InternalMetric{counter("xact_commit", 0.)},
+		},
+		{
+			name:     "rate after counter reset",
+			metrics:  []model.InternalMetric{counter("xact_commit", 20.)},
+			previous: map[string]float64{"general/xact_commit": 100},
+			interval: 10 * time.Second,
+			mode:     model.MetricsModeRate,
+			want:     []model.InternalMetric{reset(counter("xact_commit", 2.))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toCounterDeltas(tt.metrics, tt.previous, tt.interval, tt.mode)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toCounterDeltas() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCounterSamplesSwap(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	type call struct {
+		client  string
+		value   float64
+		takenAt time.Time
+	}
+
+	tests := []struct {
+		name         string
+		calls        []call
+		wantPrevious map[string]float64
+		wantAt       time.Time
+	}{
+		{
+			name:  "first sample of client",
+			calls: []call{{client: "a", value: 10, takenAt: start}},
+		},
+		{
+			name: "previous sample of client",
+			calls: []call{
+				{client: "a", value: 10, takenAt: start},
+				{client: "a", value: 25, takenAt: start.Add(time.Minute)},
+			},
+			wantPrevious: map[string]float64{"general/xact_commit": 10},
+			wantAt:       start,
+		},
+		{
+			name: "samples of other clients are not shared",
+			calls: []call{
+				{client: "a", value: 10, takenAt: start},
+				{client: "b", value: 20, takenAt: start.Add(time.Second)},
+				{client: "a", value: 30, takenAt: start.Add(time.Minute)},
+			},
+			wantPrevious: map[string]float64{"general/xact_commit": 10},
+			wantAt:       start,
+		},
+		{
+			name: "sample kept up to the ttl",
+			calls: []call{
+				{client: "a", value: 10, takenAt: start},
+				{client: "b", value: 20, takenAt: start.Add(counterBaselineTTL)},
+				{client: "a", value: 30, takenAt: start.Add(counterBaselineTTL)},
+			},
+			wantPrevious: map[string]float64{"general/xact_commit": 10},
+			wantAt:       start,
+		},
+		{
+			name: "sample expired after the ttl",
+			calls: []call{
+				{client: "a", value: 10, takenAt: start},
+				{client: "b", value: 20, takenAt: start.Add(counterBaselineTTL + time.Second)},
+				{client: "a", value: 30, takenAt: start.Add(counterBaselineTTL + time.Minute)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples := newCounterSamples()
+
+			var (
+				previous map[string]float64
+				at       time.Time
+			)
+			for _, c := range tt.calls {
+				previous, at = samples.swap(c.client, []model.InternalMetric{counter("xact_commit", c.value)}, c.takenAt)
+			}
+
+			if len(previous) != 0 || len(tt.wantPrevious) != 0 {
+				if !reflect.DeepEqual(previous, tt.wantPrevious) {
+					t.Errorf("swap() previous = %v, want %v", previous, tt.wantPrevious)
+				}
+			}
+			if !at.Equal(tt.wantAt) {
+				t.Errorf("swap() taken at = %v, want %v", at, tt.wantAt)
+			}
+
+			last := tt.calls[len(tt.calls)-1]
+			if samples.empty(last.client) {
+				t.Errorf("empty(%q) = true after swap", last.client)
+			}
+		})
+	}
+}
+
+func counter(name string, value any) model.InternalMetric {
+	return model.InternalMetric{Name: name, Value: value, Scope: model.General, Counter: true}
+}
+
+func tableCounter(name string, value any) model.InternalMetric {
+	return model.InternalMetric{Name: name, Value: value, Scope: model.Table, Counter: true}
+}
+
+func gauge(name string, value any) model.InternalMetric {
+	return model.InternalMetric{Name: name, Value: value, Scope: model.General}
+}
+
+func reset(metric model.InternalMetric) model.InternalMetric {
+	metric.CounterReset = true
+	return metric
+}
@@ -0,0 +1,95 @@
+package selector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"postgresHelper/internal/model"
+)
+
+func (i *Implementation) ListBlockingTree(ctx context.Context) ([]model.BlockingNode, error) {
+	waits, _, err := i.c.CollectLockWaits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("i.c.CollectLockWaits: %w", err)
+	}
+	return buildBlockingTree(waits), nil
+}
+
+// buildBlockingTree arranges lock waits into trees rooted at backends that block others
+// without waiting. A backend blocked by several backends appears under each of them,
+// its subtree is built once and shared. Backends waiting in a cycle, which the deadlock
+// detector has not broken yet, are rooted at the lowest pid of the cycle.
+func buildBlockingTree(waits []model.LockWait) []model.BlockingNode {
+	blocked := make(map[int64][]int64)
+	nodes := make(map[int64]model.BlockingNode)
+	for _, wait := range waits {
+		blocked[wait.BlockingPid] = append(blocked[wait.BlockingPid], wait.BlockedPid)
+		nodes[wait.BlockedPid] = model.BlockingNode{
+			Pid:      wait.BlockedPid,
+			User:     wait.BlockedUser,
+			Query:    wait.BlockedQuery,
+			State:    wait.BlockedState,
+			LockMode: wait.LockMode,
+			LockType: wait.LockType,
+			Relation: wait.Relation,
+			WaitTime: wait.WaitTime,
+		}
+	}
+	for _, wait := range waits {
+		if _, ok := nodes[wait.BlockingPid]; !ok {
+			nodes[wait.BlockingPid] = model.BlockingNode{
+				Pid:   wait.BlockingPid,
+				User:  wait.BlockingUser,
+				Query: wait.BlockingQuery,
+				State: wait.BlockingState,
+			}
+		}
+	}
+
+	blockingPids := make([]int64, 0, len(blocked))
+	for pid, pids := range blocked {
+		slices.Sort(pids)
+		blocked[pid] = slices.Compact(pids)
+		blockingPids = append(blockingPids, pid)
+	}
+	slices.Sort(blockingPids)
+
+	built := make(map[int64]model.BlockingNode, len(nodes))
+	onPath := make(map[int64]bool)
+	var build func(pid int64) model.BlockingNode
+	build = func(pid int64) model.BlockingNode {
+		if node, ok := built[pid]; ok {
+			return node
+		}
+
+		node := nodes[pid]
+		onPath[pid] = true
+		for _, blockedPid := range blocked[pid] {
+			// an edge back into the path closes a cycle
+			if !onPath[blockedPid] {
+				node.Blocked = append(node.Blocked, build(blockedPid))
+			}
+		}
+		delete(onPath, pid)
+
+		built[pid] = node
+		return node
+	}
+
+	var roots []model.BlockingNode
+	for _, pid := range blockingPids {
+		if nodes[pid].LockType == "" {
+			roots = append(roots, build(pid))
+		}
+	}
+
+	// whatever is left unbuilt waits in a cycle
+	for _, pid := range blockingPids {
+		if _, ok := built[pid]; !ok {
+			roots = append(roots, build(pid))
+		}
+	}
+
+	return roots
+}
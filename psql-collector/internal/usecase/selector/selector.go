@@ -2,17 +2,26 @@ package selector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"time"
 
+	"postgresHelper/internal/autovacuum"
 	"postgresHelper/internal/config"
 	"postgresHelper/internal/model"
 )
 
 type Selector interface {
 	ListAllMetrics(ctx context.Context) ([]model.InternalMetric, error)
-	ListAllAggregatedMetrics(ctx context.Context) ([]model.InternalMetric, error)
+	ListAllAggregatedMetrics(ctx context.Context, mode model.MetricsMode, window time.Duration, client string) ([]model.InternalMetric, time.Duration, error)
 	ListKnobs(ctx context.Context) ([]model.Knob, error)
+	ListTopStatements(ctx context.Context, limit int, orderBy model.StatementsOrder) ([]model.StatementStat, error)
+	ListBlockingTree(ctx context.Context) ([]model.BlockingNode, error)
+	ListWaitEvents(ctx context.Context, window time.Duration) model.WaitEventHistogram
+	GetReplication(ctx context.Context) (model.ReplicationState, error)
+	GetAutovacuumState(ctx context.Context, longTransaction time.Duration) (autovacuum.VacuumStats, error)
+	SubscribeAggregatedMetrics(ctx context.Context, interval time.Duration, groups []model.MetricGroup, mode model.MetricsMode) (<-chan model.MetricSnapshot, error)
 }
 
 type MetricCollector interface {
@@ -23,16 +32,70 @@ type MetricCollector interface {
 	CollectTablesBloat(ctx context.Context) ([]model.TableBloating, model.Scope, error)
 	CollectIndexesBloat(ctx context.Context) ([]model.IndexBloating, model.Scope, error)
 	CollectDatabaseStat(ctx context.Context, databaseName string) (model.DatabaseStat, model.Scope, error)
+	CollectWalStat(ctx context.Context) (model.WalStat, model.Scope, error)
+	CollectIOStats(ctx context.Context) ([]model.IOStat, model.Scope, error)
 	CollectKnobs(ctx context.Context) ([]model.Knob, error)
+	CollectTopStatements(ctx context.Context, limit int, orderBy model.StatementsOrder) ([]model.StatementStat, error)
+	CollectLockWaits(ctx context.Context) ([]model.LockWait, model.Scope, error)
+	CollectReplication(ctx context.Context) (model.ReplicationState, model.Scope, error)
 }
 
-func New(c MetricCollector, config config.Postgres) *Implementation {
-	return &Implementation{c: c, config: config}
+type Policy interface {
+	Apply(knobs []model.Knob) []model.Knob
+}
+
+type Autovacuum interface {
+	CollectAutovacuumState(ctx context.Context, longTransaction time.Duration) (autovacuum.VacuumStats, error)
+}
+
+type WaitSampler interface {
+	Histogram(window time.Duration) model.WaitEventHistogram
+}
+
+const (
+	// defaultCounterWindow is used to compute deltas when there is no previous sample yet.
+	defaultCounterWindow = time.Second
+
+	// defaultWaitWindow is the period wait events are aggregated over when it is not configured.
+	defaultWaitWindow = time.Minute
+
+	// defaultLongTransaction is the age of transactions counted as long-running, they keep vacuum from removing dead rows.
+	defaultLongTransaction = 5 * time.Minute
+
+	defaultTopStatementsLimit = 10
+	maxTopStatementsLimit     = 1000
+)
+
+func New(c MetricCollector, config config.Postgres, policy Policy, waits WaitSampler, waitsConfig config.WaitSampler, vacuum Autovacuum) *Implementation {
+	waitWindow := waitsConfig.Window
+	if waitWindow <= 0 {
+		waitWindow = defaultWaitWindow
+	}
+
+	return &Implementation{
+		c:          c,
+		config:     config,
+		policy:     policy,
+		counters:   newCounterSamples(),
+		waits:      waits,
+		waitWindow: waitWindow,
+		vacuum:     vacuum,
+		streams:    newStreams(),
+	}
 }
 
 type Implementation struct {
-	c      MetricCollector
-	config config.Postgres
+	c        MetricCollector
+	config   config.Postgres
+	policy   Policy
+	counters *counterSamples
+
+	waits      WaitSampler
+	waitWindow time.Duration
+
+	vacuum Autovacuum
+
+	streams *streams
 }
 
 func (i *Implementation) listAggregatedTableBloatMetrics(ctx context.Context) ([]model.InternalMetric, error) {
@@ -48,9 +111,9 @@ func (i *Implementation) listAggregatedTableBloatMetrics(ctx context.Context) ([
 	}
 
 	statContainingMaximimBloat := slices.MaxFunc(tableBloatStats, func(a, b model.TableBloating) int {
-		if a.BloatInPercent < b.BloatInPercent {
+		if a.TableBloatInPercent < b.TableBloatInPercent {
 			return -1
-		} else if a.BloatInPercent > b.BloatInPercent {
+		} else if a.TableBloatInPercent > b.TableBloatInPercent {
 			return 1
 		}
 		return 0
@@ -72,9 +135,9 @@ func (i *Implementation) listAggregatedIndexBloatMetrics(ctx context.Context) ([
 	}
 
 	statContainingMaximimBloat := slices.MaxFunc(tableIndexBloatStats, func(a, b model.IndexBloating) int {
-		if a.BloatInPercent < b.BloatInPercent {
+		if a.IndexBloatInPercent < b.IndexBloatInPercent {
 			return -1
-		} else if a.BloatInPercent > b.BloatInPercent {
+		} else if a.IndexBloatInPercent > b.IndexBloatInPercent {
 			return 1
 		}
 		return 0
@@ -98,7 +161,75 @@ func (i *Implementation) listAggregatedTableInfoMetrics(ctx context.Context) ([]
 	return tablesInfoAggregatedMetrics, nil
 }
 
-func (i *Implementation) ListAllAggregatedMetrics(ctx context.Context) ([]model.InternalMetric, error) {
+// ListAllAggregatedMetrics returns aggregated metrics with counters reported according to mode.
+// Deltas are computed against the sample taken by the previous call of the same client, or against
+// a sample taken window before when window is set. The returned duration is the interval deltas cover.
+// Raw calls do not replace the sample of the client.
+func (i *Implementation) ListAllAggregatedMetrics(ctx context.Context, mode model.MetricsMode, window time.Duration, client string) ([]model.InternalMetric, time.Duration, error) {
+	var (
+		baseline   map[string]float64
+		baselineAt time.Time
+	)
+	if mode != model.MetricsModeRaw && (window > 0 || i.counters.empty(client)) {
+		if window <= 0 {
+			window = defaultCounterWindow
+		}
+
+		counters, err := i.listCounterMetrics(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("i.listCounterMetrics: %w", err)
+		}
+		baseline, baselineAt = counterValues(counters), time.Now()
+
+		select {
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-time.After(window):
+		}
+	}
+
+	metrics, err := i.listAllAggregatedMetrics(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if mode == model.MetricsModeRaw {
+		return metrics, 0, nil
+	}
+
+	takenAt := time.Now()
+	previous, previousAt := i.counters.swap(client, metrics, takenAt)
+	if baseline != nil {
+		previous, previousAt = baseline, baselineAt
+	}
+
+	interval := takenAt.Sub(previousAt)
+	return toCounterDeltas(metrics, previous, interval, mode), interval, nil
+}
+
+// SampleAggregatedMetrics returns aggregated metrics of groups with raw counters without replacing
+// the sample deltas of ListAllAggregatedMetrics are computed against. Groups are collected independently,
+// metrics of groups that succeeded are returned along with an error joining the failures of the others.
+// The sample, or errors of its groups, is also delivered to subscribers of SubscribeAggregatedMetrics.
+func (i *Implementation) SampleAggregatedMetrics(ctx context.Context, groups []model.MetricGroup) ([]model.InternalMetric, error) {
+	dueAt := time.Now()
+	metrics, groupErrs := i.listAggregatedMetricGroups(ctx, groups)
+	i.publishStreams(dueAt, time.Now(), metrics, groupErrs)
+
+	var errs []error
+	for _, group := range model.MetricGroups {
+		if err, ok := groupErrs[group]; ok {
+			errs = append(errs, err)
+		}
+	}
+	var sample []model.InternalMetric
+	for _, group := range model.MetricGroups {
+		sample = append(sample, metrics[group]...)
+	}
+	return sample, errors.Join(errs...)
+}
+
+func (i *Implementation) listCounterMetrics(ctx context.Context) ([]model.InternalMetric, error) {
 	var metrics []model.InternalMetric
 
 	databaseStat, scope, err := i.c.CollectDatabaseStat(ctx, i.config.Database)
@@ -113,11 +244,21 @@ func (i *Implementation) ListAllAggregatedMetrics(ctx context.Context) ([]model.
 	}
 	metrics = append(metrics, model.ToInternalMetric(walWriteStat, scope)...)
 
-	sharedBufferHitRate, scope, err := i.c.CalculateSharedBufferHitRate(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("c.CalculateSharedBufferHitRate: %w", err)
+	walStat, scope, err := i.c.CollectWalStat(ctx)
+	if err != nil && !errors.Is(err, model.ErrNotSupported) {
+		return nil, fmt.Errorf("c.CollectWalStat: %w", err)
+	}
+	if err == nil {
+		metrics = append(metrics, model.ToInternalMetric(walStat, scope)...)
+	}
+
+	ioStats, scope, err := i.c.CollectIOStats(ctx)
+	if err != nil && !errors.Is(err, model.ErrNotSupported) {
+		return nil, fmt.Errorf("c.CollectIOStats: %w", err)
+	}
+	if err == nil {
+		metrics = append(metrics, model.ToInternalMetric(model.AggregateIOStats(ioStats), scope)...)
 	}
-	metrics = append(metrics, model.ToInternalMetric(sharedBufferHitRate, scope)...)
 
 	distribution, scope, err := i.c.CollectQueryTypesDistribution(ctx)
 	if err != nil {
@@ -125,28 +266,117 @@ func (i *Implementation) ListAllAggregatedMetrics(ctx context.Context) ([]model.
 	}
 	metrics = append(metrics, model.ToInternalMetric(distribution, scope)...)
 
-	aggregatedIndexBloat, err := i.listAggregatedIndexBloatMetrics(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("i.ListAggregatedIndexBloatMetrics: %w", err)
+	return metrics, nil
+}
+
+func (i *Implementation) listAllAggregatedMetrics(ctx context.Context) ([]model.InternalMetric, error) {
+	groups, errs := i.listAggregatedMetricGroups(ctx, model.MetricGroups)
+
+	var metrics []model.InternalMetric
+	for _, group := range model.MetricGroups {
+		if err, ok := errs[group]; ok {
+			return nil, err
+		}
+		metrics = append(metrics, groups[group]...)
 	}
-	metrics = append(metrics, aggregatedIndexBloat...)
+	return metrics, nil
+}
 
-	aggregatedTableBloat, err := i.listAggregatedTableBloatMetrics(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("i.ListAggregatedIndexBloatMetrics: %w", err)
+// listAggregatedMetricGroups collects each group on its own, a failing group does not keep
+// the others from being collected and is reported in errs instead of metrics.
+func (i *Implementation) listAggregatedMetricGroups(ctx context.Context, groups []model.MetricGroup) (metrics map[model.MetricGroup][]model.InternalMetric, errs map[model.MetricGroup]error) {
+	metrics = make(map[model.MetricGroup][]model.InternalMetric, len(groups))
+	errs = make(map[model.MetricGroup]error)
+	for _, group := range groups {
+		groupMetrics, err := i.listAggregatedMetricGroup(ctx, group)
+		if err != nil {
+			errs[group] = fmt.Errorf("metric group %s: %w", group, err)
+			continue
+		}
+		metrics[group] = groupMetrics
+	}
+	return metrics, errs
+}
+
+func (i *Implementation) listAggregatedMetricGroup(ctx context.Context, group model.MetricGroup) ([]model.InternalMetric, error) {
+	switch group {
+	case model.MetricGroupActivity:
+		metrics, err := i.listCounterMetrics(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sharedBufferHitRate, scope, err := i.c.CalculateSharedBufferHitRate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("c.CalculateSharedBufferHitRate: %w", err)
+		}
+		return append(metrics, model.ToInternalMetric(sharedBufferHitRate, scope)...), nil
+
+	case model.MetricGroupLocks:
+		lockWaits, scope, err := i.c.CollectLockWaits(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("c.CollectLockWaits: %w", err)
+		}
+		metrics := model.ToInternalMetric(model.AggregateLockWaits(lockWaits), scope)
+
+		waitEvents := i.waits.Histogram(i.waitWindow)
+		return append(metrics, model.ToInternalMetric(model.AggregateWaitEvents(waitEvents), model.General)...), nil
+
+	case model.MetricGroupReplication:
+		replication, scope, err := i.c.CollectReplication(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("c.CollectReplication: %w", err)
+		}
+		return model.ToInternalMetric(model.AggregateReplication(replication), scope), nil
+
+	case model.MetricGroupAutovacuum:
+		vacuumStats, err := i.vacuum.CollectAutovacuumState(ctx, defaultLongTransaction)
+		if err != nil {
+			return nil, fmt.Errorf("vacuum.CollectAutovacuumState: %w", err)
+		}
+		return model.ToInternalMetric(autovacuum.Aggregate(vacuumStats), model.General), nil
+
+	case model.MetricGroupBloat:
+		metrics, err := i.listAggregatedIndexBloatMetrics(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("i.ListAggregatedIndexBloatMetrics: %w", err)
+		}
+
+		aggregatedTableBloat, err := i.listAggregatedTableBloatMetrics(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("i.ListAggregatedTableBloatMetrics: %w", err)
+		}
+		return append(metrics, aggregatedTableBloat...), nil
+
+	case model.MetricGroupTables:
+		metrics, err := i.listAggregatedTableInfoMetrics(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("i.ListAggregatedTableInfoMetrics: %w", err)
+		}
+		return metrics, nil
+
+	default:
+		return nil, fmt.Errorf("unknown metric group %d", group)
 	}
-	metrics = append(metrics, aggregatedTableBloat...)
+}
 
-	aggregatedTablesInfo, err := i.listAggregatedTableInfoMetrics(ctx)
+// ListAllMetrics returns per-table, per-index and server-wide metrics, per-row metrics
+// carry the table and index names as labels.
+func (i *Implementation) ListAllMetrics(ctx context.Context) ([]model.InternalMetric, error) {
+	metrics, err := i.ListMetrics(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("i.ListAggregatedIndexBloatMetrics: %w", err)
+		return nil, err
 	}
-	metrics = append(metrics, aggregatedTablesInfo...)
 
-	return metrics, nil
+	bloat, err := i.ListBloatMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(metrics, bloat...), nil
 }
 
-func (i *Implementation) ListAllMetrics(ctx context.Context) ([]model.InternalMetric, error) {
+// ListMetrics is ListAllMetrics without bloat estimates, which scan every table and index.
+func (i *Implementation) ListMetrics(ctx context.Context) ([]model.InternalMetric, error) {
 	var metrics []model.InternalMetric
 
 	databaseStat, scope, err := i.c.CollectDatabaseStat(ctx, i.config.Database)
@@ -160,7 +390,7 @@ func (i *Implementation) ListAllMetrics(ctx context.Context) ([]model.InternalMe
 		return nil, fmt.Errorf("c.CollectTablesInfo: %w", err)
 	}
 	for _, stat := range tableInfoStats {
-		metrics = append(metrics, model.ToInternalMetric(stat, scope)...)
+		metrics = append(metrics, model.ToLabeledInternalMetric(stat, scope)...)
 	}
 
 	walWriteStat, scope, err := i.c.CollectWalWriteAndFlushStat(ctx)
@@ -169,20 +399,20 @@ func (i *Implementation) ListAllMetrics(ctx context.Context) ([]model.InternalMe
 	}
 	metrics = append(metrics, model.ToInternalMetric(walWriteStat, scope)...)
 
-	indexBloatStats, scope, err := i.c.CollectIndexesBloat(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("c.CollectIndexesBloat: %w", err)
+	walStat, scope, err := i.c.CollectWalStat(ctx)
+	if err != nil && !errors.Is(err, model.ErrNotSupported) {
+		return nil, fmt.Errorf("c.CollectWalStat: %w", err)
 	}
-	for _, stat := range indexBloatStats {
-		metrics = append(metrics, model.ToInternalMetric(stat, scope)...)
+	if err == nil {
+		metrics = append(metrics, model.ToInternalMetric(walStat, scope)...)
 	}
 
-	tableBloatStats, scope, err := i.c.CollectTablesBloat(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("c.CollectTablesBloat: %w", err)
+	ioStats, scope, err := i.c.CollectIOStats(ctx)
+	if err != nil && !errors.Is(err, model.ErrNotSupported) {
+		return nil, fmt.Errorf("c.CollectIOStats: %w", err)
 	}
-	for _, stat := range tableBloatStats {
-		metrics = append(metrics, model.ToInternalMetric(stat, scope)...)
+	for _, stat := range ioStats {
+		metrics = append(metrics, model.ToLabeledInternalMetric(stat, scope)...)
 	}
 
 	sharedBufferHitRate, scope, err := i.c.CalculateSharedBufferHitRate(ctx)
@@ -197,6 +427,35 @@ func (i *Implementation) ListAllMetrics(ctx context.Context) ([]model.InternalMe
 	}
 	metrics = append(metrics, model.ToInternalMetric(distribution, scope)...)
 
+	replication, scope, err := i.c.CollectReplication(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("c.CollectReplication: %w", err)
+	}
+	metrics = append(metrics, model.ToInternalMetric(model.AggregateReplication(replication), scope)...)
+
+	return metrics, nil
+}
+
+// ListBloatMetrics returns table and index bloat estimates labeled with the table and index names.
+func (i *Implementation) ListBloatMetrics(ctx context.Context) ([]model.InternalMetric, error) {
+	var metrics []model.InternalMetric
+
+	indexBloatStats, scope, err := i.c.CollectIndexesBloat(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("c.CollectIndexesBloat: %w", err)
+	}
+	for _, stat := range indexBloatStats {
+		metrics = append(metrics, model.ToLabeledInternalMetric(stat, scope)...)
+	}
+
+	tableBloatStats, scope, err := i.c.CollectTablesBloat(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("c.CollectTablesBloat: %w", err)
+	}
+	for _, stat := range tableBloatStats {
+		metrics = append(metrics, model.ToLabeledInternalMetric(stat, scope)...)
+	}
+
 	return metrics, nil
 }
 
@@ -205,5 +464,48 @@ func (i *Implementation) ListKnobs(ctx context.Context) ([]model.Knob, error) {
 	if err != nil {
 		return nil, fmt.Errorf("i.c.CollectKnobs: %w", err)
 	}
-	return knobs, nil
+	return i.policy.Apply(knobs), nil
+}
+
+func (i *Implementation) ListTopStatements(ctx context.Context, limit int, orderBy model.StatementsOrder) ([]model.StatementStat, error) {
+	if limit <= 0 {
+		limit = defaultTopStatementsLimit
+	}
+	limit = min(limit, maxTopStatementsLimit)
+
+	stats, err := i.c.CollectTopStatements(ctx, limit, orderBy)
+	if err != nil {
+		return nil, fmt.Errorf("i.c.CollectTopStatements: %w", err)
+	}
+	return stats, nil
+}
+
+// ListWaitEvents returns the histogram of sampled wait events over window, or over the configured window when it is zero.
+func (i *Implementation) ListWaitEvents(_ context.Context, window time.Duration) model.WaitEventHistogram {
+	if window <= 0 {
+		window = i.waitWindow
+	}
+	return i.waits.Histogram(window)
+}
+
+func (i *Implementation) GetReplication(ctx context.Context) (model.ReplicationState, error) {
+	state, _, err := i.c.CollectReplication(ctx)
+	if err != nil {
+		return model.ReplicationState{}, fmt.Errorf("i.c.CollectReplication: %w", err)
+	}
+	return state, nil
+}
+
+// GetAutovacuumState returns autovacuum settings, per-table statistics and transactions open
+// for longer than longTransaction, or than the default of 5 minutes when it is zero.
+func (i *Implementation) GetAutovacuumState(ctx context.Context, longTransaction time.Duration) (autovacuum.VacuumStats, error) {
+	if longTransaction <= 0 {
+		longTransaction = defaultLongTransaction
+	}
+
+	stats, err := i.vacuum.CollectAutovacuumState(ctx, longTransaction)
+	if err != nil {
+		return autovacuum.VacuumStats{}, fmt.Errorf("i.vacuum.CollectAutovacuumState: %w", err)
+	}
+	return stats, nil
 }
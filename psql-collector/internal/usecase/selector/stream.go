@@ -0,0 +1,195 @@
+package selector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+const (
+	defaultStreamInterval = 10 * time.Second
+
+	// streamSlack keeps sampling jitter from delaying a subscriber by a whole runner interval
+	streamSlack = 500 * time.Millisecond
+)
+
+// streams fans out the samples taken by SampleAggregatedMetrics to subscribers, so subscribers
+// never query PostgreSQL on their own and each group is queried once per sample.
+type streams struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+
+	// sampled are groups the runner samples, only they can be subscribed to
+	sampled []model.MetricGroup
+}
+
+type subscriber struct {
+	interval time.Duration
+	groups   []model.MetricGroup
+	mode     model.MetricsMode
+	next     time.Time
+	ch       chan model.MetricSnapshot
+
+	// baselines hold counters of the previous sample of each group in delta and rate modes
+	baselines map[model.MetricGroup]counterBaseline
+}
+
+type counterBaseline struct {
+	values  map[string]float64
+	takenAt time.Time
+}
+
+func newStreams() *streams {
+	return &streams{subscribers: make(map[*subscriber]struct{})}
+}
+
+// SetSampledGroups sets the groups the runner samples, only they can be subscribed to.
+func (i *Implementation) SetSampledGroups(groups []model.MetricGroup) {
+	i.streams.mu.Lock()
+	defer i.streams.mu.Unlock()
+
+	i.streams.sampled = slices.Clone(groups)
+}
+
+// SubscribeAggregatedMetrics delivers aggregated metrics of groups sampled by the runner, at most every
+// interval, until ctx is done, then the channel is closed. Snapshots are only as frequent as the runner samples
+// the groups, so a shorter interval than the sampling interval of a group is not honored. Empty groups means
+// all sampled groups, subscribing to a group the runner does not sample fails with model.ErrMetricGroupNotSampled.
+// A snapshot holds the subscribed groups of the latest sample. In delta and rate modes counters of a group cover
+// the time since its previous sample, so a group appears from its second sample on. A failure to sample a subscribed
+// group is delivered as a snapshot with Err. A subscriber that does not keep up receives only the latest snapshot.
+func (i *Implementation) SubscribeAggregatedMetrics(ctx context.Context, interval time.Duration, groups []model.MetricGroup, mode model.MetricsMode) (<-chan model.MetricSnapshot, error) {
+	if interval <= 0 {
+		interval = defaultStreamInterval
+	}
+
+	i.streams.mu.Lock()
+	sampled := i.streams.sampled
+	i.streams.mu.Unlock()
+
+	if len(sampled) == 0 {
+		return nil, fmt.Errorf("%w: no metric groups are sampled", model.ErrMetricGroupNotSampled)
+	}
+	for _, group := range groups {
+		if !slices.Contains(sampled, group) {
+			return nil, fmt.Errorf("%w: %s", model.ErrMetricGroupNotSampled, group)
+		}
+	}
+
+	// groups are kept in the order metrics are reported in
+	subGroups := slices.DeleteFunc(slices.Clone(model.MetricGroups), func(group model.MetricGroup) bool {
+		if len(groups) == 0 {
+			return !slices.Contains(sampled, group)
+		}
+		return !slices.Contains(groups, group)
+	})
+
+	sub := &subscriber{
+		interval:  interval,
+		groups:    subGroups,
+		mode:      mode,
+		next:      time.Now(),
+		ch:        make(chan model.MetricSnapshot, 1),
+		baselines: make(map[model.MetricGroup]counterBaseline),
+	}
+
+	i.streams.mu.Lock()
+	i.streams.subscribers[sub] = struct{}{}
+	i.streams.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		i.streams.mu.Lock()
+		defer i.streams.mu.Unlock()
+
+		delete(i.streams.subscribers, sub)
+		close(sub.ch)
+	}()
+
+	return sub.ch, nil
+}
+
+// publishStreams sends a sample started at dueAt to subscribers that are due and subscribed
+// to any of its groups, or the errors of the subscribed groups that failed.
+func (i *Implementation) publishStreams(dueAt, takenAt time.Time, metrics map[model.MetricGroup][]model.InternalMetric, errs map[model.MetricGroup]error) {
+	i.streams.mu.Lock()
+	defer i.streams.mu.Unlock()
+
+	for sub := range i.streams.subscribers {
+		if sub.next.Sub(dueAt) > streamSlack {
+			continue
+		}
+
+		if err := sub.failure(errs); err != nil {
+			sub.next = dueAt.Add(sub.interval)
+			sub.send(model.MetricSnapshot{TakenAt: takenAt, Err: err})
+			continue
+		}
+
+		snapshot, sampled := sub.snapshot(takenAt, metrics)
+		if !sampled {
+			continue
+		}
+		sub.next = dueAt.Add(sub.interval)
+		if snapshot.Metrics == nil {
+			continue
+		}
+		sub.send(snapshot)
+	}
+}
+
+// failure joins errors of the subscribed groups that failed to be sampled.
+func (sub *subscriber) failure(errs map[model.MetricGroup]error) error {
+	var groupErrs []error
+	for _, group := range sub.groups {
+		if err, ok := errs[group]; ok {
+			groupErrs = append(groupErrs, err)
+		}
+	}
+	return errors.Join(groupErrs...)
+}
+
+// snapshot builds the snapshot of the subscribed groups of a sample, sampled is false when the sample
+// has none of them. In delta and rate modes groups without a baseline are left out.
+func (sub *subscriber) snapshot(takenAt time.Time, metrics map[model.MetricGroup][]model.InternalMetric) (model.MetricSnapshot, bool) {
+	snapshot := model.MetricSnapshot{TakenAt: takenAt}
+	sampled := false
+	for _, group := range sub.groups {
+		groupMetrics, ok := metrics[group]
+		if !ok {
+			continue
+		}
+		sampled = true
+
+		if sub.mode == model.MetricsModeRaw {
+			snapshot.Metrics = append(snapshot.Metrics, groupMetrics...)
+			continue
+		}
+
+		baseline, found := sub.baselines[group]
+		sub.baselines[group] = counterBaseline{values: counterValues(groupMetrics), takenAt: takenAt}
+		if !found {
+			continue
+		}
+
+		interval := takenAt.Sub(baseline.takenAt)
+		snapshot.Interval = max(snapshot.Interval, interval)
+		snapshot.Metrics = append(snapshot.Metrics, toCounterDeltas(groupMetrics, baseline.values, interval, sub.mode)...)
+	}
+	return snapshot, sampled
+}
+
+// send replaces an unread snapshot, the channel keeps only the latest one for slow subscribers.
+func (sub *subscriber) send(snapshot model.MetricSnapshot) {
+	select {
+	case <-sub.ch:
+	default:
+	}
+	sub.ch <- snapshot
+}
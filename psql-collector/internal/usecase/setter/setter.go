@@ -2,27 +2,88 @@ package setter
 
 import (
 	"context"
+	"fmt"
 	"postgresHelper/internal/model"
 )
 
 type Setter interface {
-	SetKnobs(ctx context.Context, knobs []model.Knob) error
+	SetKnobs(ctx context.Context, knobs []model.Knob) ([]model.KnobApplyResult, error)
 }
 
 type Collector interface {
-	SetKnobs(ctx context.Context, knobs []model.Knob) error
+	SetKnobs(ctx context.Context, knobs []model.Knob) ([]model.KnobApplyResult, error)
+	CollectAutoConf(ctx context.Context) ([]model.AutoConfSetting, error)
+	RestoreAutoConf(ctx context.Context, settings []model.AutoConfSetting) ([]model.KnobApplyResult, error)
+}
+
+type Policy interface {
+	IsAllowed(name string) bool
+	Check(knob model.Knob) error
 }
 
 type Implementation struct {
 	collector Collector
+	policy    Policy
 }
 
-func New(collector Collector) *Implementation {
+func New(collector Collector, policy Policy) *Implementation {
 	return &Implementation{
 		collector: collector,
+		policy:    policy,
 	}
 }
 
-func (i *Implementation) SetKnobs(ctx context.Context, knobs []model.Knob) error {
+// SetKnobs refuses the whole request when any knob violates the policy.
+func (i *Implementation) SetKnobs(ctx context.Context, knobs []model.Knob) ([]model.KnobApplyResult, error) {
+	for _, knob := range knobs {
+		if err := i.policy.Check(knob); err != nil {
+			return nil, fmt.Errorf("policy.Check: %w", err)
+		}
+	}
+
 	return i.collector.SetKnobs(ctx, knobs)
 }
+
+// RestoreAutoConf brings postgresql.auto.conf back to settings for allowed knobs only,
+// entries of knobs the policy does not allow are kept as they are now.
+// Saved values were read from the server, so they are not checked against policy bounds.
+func (i *Implementation) RestoreAutoConf(ctx context.Context, settings []model.AutoConfSetting) ([]model.KnobApplyResult, error) {
+	current, err := i.collector.CollectAutoConf(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collector.CollectAutoConf: %w", err)
+	}
+
+	target := make([]model.AutoConfSetting, 0, len(settings))
+	for _, setting := range settings {
+		if i.policy.IsAllowed(setting.Name) {
+			target = append(target, setting)
+		}
+	}
+	for _, setting := range current {
+		if !i.policy.IsAllowed(setting.Name) {
+			target = append(target, setting)
+		}
+	}
+
+	results, err := i.collector.RestoreAutoConf(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("collector.RestoreAutoConf: %w", err)
+	}
+	return results, nil
+}
+
+// ResetKnobs removes postgresql.auto.conf entries of allowed knobs and returns knobs pending restart.
+func (i *Implementation) ResetKnobs(ctx context.Context) ([]string, error) {
+	results, err := i.RestoreAutoConf(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("i.RestoreAutoConf: %w", err)
+	}
+
+	var pendingRestart []string
+	for _, result := range results {
+		if result.Status == model.KnobPendingRestart {
+			pendingRestart = append(pendingRestart, result.Name)
+		}
+	}
+	return pendingRestart, nil
+}
@@ -0,0 +1,106 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"postgresHelper/internal/model"
+)
+
+type Snapshotter interface {
+	CreateSnapshot(ctx context.Context, name string) (model.KnobSnapshot, error)
+	ListSnapshots(ctx context.Context) []model.KnobSnapshot
+	RestoreSnapshot(ctx context.Context, name string) ([]model.KnobApplyResult, error)
+	ResetKnobs(ctx context.Context) ([]string, error)
+}
+
+type Collector interface {
+	CollectKnobs(ctx context.Context) ([]model.Knob, error)
+	CollectAutoConf(ctx context.Context) ([]model.AutoConfSetting, error)
+}
+
+// Setter writes knobs through the knob policy, so denied knobs are never set or reset.
+type Setter interface {
+	RestoreAutoConf(ctx context.Context, settings []model.AutoConfSetting) ([]model.KnobApplyResult, error)
+	ResetKnobs(ctx context.Context) ([]string, error)
+}
+
+type Storage interface {
+	SaveKnobSnapshot(snapshot model.KnobSnapshot) error
+	GetKnobSnapshot(name string) (model.KnobSnapshot, error)
+	ListKnobSnapshots() []model.KnobSnapshot
+}
+
+type Implementation struct {
+	collector Collector
+	setter    Setter
+	storage   Storage
+}
+
+func New(collector Collector, setter Setter, storage Storage) *Implementation {
+	return &Implementation{
+		collector: collector,
+		setter:    setter,
+		storage:   storage,
+	}
+}
+
+func (i *Implementation) CreateSnapshot(ctx context.Context, name string) (model.KnobSnapshot, error) {
+	if _, err := i.storage.GetKnobSnapshot(name); err == nil {
+		return model.KnobSnapshot{}, fmt.Errorf("%w: %s", model.ErrSnapshotAlreadyExists, name)
+	}
+
+	knobs, err := i.collector.CollectKnobs(ctx)
+	if err != nil {
+		return model.KnobSnapshot{}, fmt.Errorf("collector.CollectKnobs: %w", err)
+	}
+	autoConf, err := i.collector.CollectAutoConf(ctx)
+	if err != nil {
+		return model.KnobSnapshot{}, fmt.Errorf("collector.CollectAutoConf: %w", err)
+	}
+
+	snapshot := model.KnobSnapshot{
+		Name:      name,
+		CreatedAt: time.Now(),
+		AutoConf:  autoConf,
+	}
+	for _, knob := range knobs {
+		if knob.IsTunable() {
+			snapshot.Knobs = append(snapshot.Knobs, knob)
+		}
+	}
+
+	if err := i.storage.SaveKnobSnapshot(snapshot); err != nil {
+		return model.KnobSnapshot{}, fmt.Errorf("storage.SaveKnobSnapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+func (i *Implementation) ListSnapshots(_ context.Context) []model.KnobSnapshot {
+	return i.storage.ListKnobSnapshots()
+}
+
+// RestoreSnapshot brings postgresql.auto.conf back to its content at snapshot time, so only knobs
+// changed with ALTER SYSTEM since then are reset or set again. Knobs denied by the policy are not touched.
+// The result lists changed knobs.
+func (i *Implementation) RestoreSnapshot(ctx context.Context, name string) ([]model.KnobApplyResult, error) {
+	snapshot, err := i.storage.GetKnobSnapshot(name)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetKnobSnapshot: %w", err)
+	}
+
+	results, err := i.setter.RestoreAutoConf(ctx, snapshot.AutoConf)
+	if err != nil {
+		return nil, fmt.Errorf("setter.RestoreAutoConf: %w", err)
+	}
+	return results, nil
+}
+
+func (i *Implementation) ResetKnobs(ctx context.Context) ([]string, error) {
+	pendingRestart, err := i.setter.ResetKnobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("setter.ResetKnobs: %w", err)
+	}
+	return pendingRestart, nil
+}
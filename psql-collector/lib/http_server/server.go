@@ -0,0 +1,60 @@
+package http_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type HTTPConfig struct {
+	Enabled bool   `yaml:"enabled"` // Server is started only when enabled
+	Host    string `yaml:"host"`    // Server Host
+	Port    int    `yaml:"port"`    // Server Port
+}
+
+type HTTPServer struct {
+	Ser  *http.Server
+	Addr string
+	lis  net.Listener
+}
+
+func NewHTTPServer(cfg *HTTPConfig, handler http.Handler) (*HTTPServer, error) {
+	c := &HTTPServer{
+		Addr: fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+	}
+	c.Ser = &http.Server{
+		Addr:              c.Addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	var err error
+	if c.lis, err = net.Listen("tcp", c.Addr); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (ser *HTTPServer) Run() {
+	go func() {
+		if err := ser.Ser.Serve(ser.lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("http server: %v", err)
+		}
+	}()
+}
+
+func (ser *HTTPServer) Close() {
+	if ser.lis == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	_ = ser.Ser.Shutdown(ctx)
+}
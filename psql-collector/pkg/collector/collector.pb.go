@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,415 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MetricsMode int32
+
+const (
+	MetricsMode_MetricsModeUnspecified MetricsMode = 0
+	// Cumulative counters as reported by PostgreSQL, the default
+	MetricsMode_Raw MetricsMode = 1
+	// Counter increase since the previous call or over the window
+	MetricsMode_Delta MetricsMode = 2
+	// Counter increase per second since the previous call or over the window
+	MetricsMode_Rate MetricsMode = 3
+)
+
+// Enum value maps for MetricsMode.
+var (
+	MetricsMode_name = map[int32]string{
+		0: "MetricsModeUnspecified",
+		1: "Raw",
+		2: "Delta",
+		3: "Rate",
+	}
+	MetricsMode_value = map[string]int32{
+		"MetricsModeUnspecified": 0,
+		"Raw":                    1,
+		"Delta":                  2,
+		"Rate":                   3,
+	}
+)
+
+func (x MetricsMode) Enum() *MetricsMode {
+	p := new(MetricsMode)
+	*p = x
+	return p
+}
+
+func (x MetricsMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[0].Descriptor()
+}
+
+func (MetricsMode) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[0]
+}
+
+func (x MetricsMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsMode.Descriptor instead.
+func (MetricsMode) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{0}
+}
+
+type MetricGroup int32
+
+const (
+	MetricGroup_MetricGroupUnspecified MetricGroup = 0
+	// Database, WAL and IO counters, query types and shared buffer hit rate
+	MetricGroup_Activity MetricGroup = 1
+	// Lock waits and sampled wait events
+	MetricGroup_Locks       MetricGroup = 2
+	MetricGroup_Replication MetricGroup = 3
+	MetricGroup_Autovacuum  MetricGroup = 4
+	// Table and index bloat estimates, the most expensive group to collect
+	MetricGroup_Bloat MetricGroup = 5
+	// Per-table statistics aggregated over tables
+	MetricGroup_Tables MetricGroup = 6
+)
+
+// Enum value maps for MetricGroup.
+var (
+	MetricGroup_name = map[int32]string{
+		0: "MetricGroupUnspecified",
+		1: "Activity",
+		2: "Locks",
+		3: "Replication",
+		4: "Autovacuum",
+		5: "Bloat",
+		6: "Tables",
+	}
+	MetricGroup_value = map[string]int32{
+		"MetricGroupUnspecified": 0,
+		"Activity":               1,
+		"Locks":                  2,
+		"Replication":            3,
+		"Autovacuum":             4,
+		"Bloat":                  5,
+		"Tables":                 6,
+	}
+)
+
+func (x MetricGroup) Enum() *MetricGroup {
+	p := new(MetricGroup)
+	*p = x
+	return p
+}
+
+func (x MetricGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[1].Descriptor()
+}
+
+func (MetricGroup) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[1]
+}
+
+func (x MetricGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricGroup.Descriptor instead.
+func (MetricGroup) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{1}
+}
+
+type KnobApplyStatus int32
+
+const (
+	KnobApplyStatus_Unspecified KnobApplyStatus = 0
+	// Value took effect after configuration reload
+	KnobApplyStatus_Applied KnobApplyStatus = 1
+	// Value is written but takes effect only after server restart
+	KnobApplyStatus_PendingRestart KnobApplyStatus = 2
+	// Server refused the value, see error
+	KnobApplyStatus_Rejected KnobApplyStatus = 3
+)
+
+// Enum value maps for KnobApplyStatus.
+var (
+	KnobApplyStatus_name = map[int32]string{
+		0: "Unspecified",
+		1: "Applied",
+		2: "PendingRestart",
+		3: "Rejected",
+	}
+	KnobApplyStatus_value = map[string]int32{
+		"Unspecified":    0,
+		"Applied":        1,
+		"PendingRestart": 2,
+		"Rejected":       3,
+	}
+)
+
+func (x KnobApplyStatus) Enum() *KnobApplyStatus {
+	p := new(KnobApplyStatus)
+	*p = x
+	return p
+}
+
+func (x KnobApplyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KnobApplyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[2].Descriptor()
+}
+
+func (KnobApplyStatus) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[2]
+}
+
+func (x KnobApplyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KnobApplyStatus.Descriptor instead.
+func (KnobApplyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{2}
+}
+
+type StatementsOrderBy int32
+
+const (
+	StatementsOrderBy_StatementsOrderByUnspecified StatementsOrderBy = 0
+	StatementsOrderBy_TotalExecTime                StatementsOrderBy = 1
+	StatementsOrderBy_Calls                        StatementsOrderBy = 2
+	StatementsOrderBy_MeanExecTime                 StatementsOrderBy = 3
+	StatementsOrderBy_Rows                         StatementsOrderBy = 4
+	StatementsOrderBy_SharedBlksRead               StatementsOrderBy = 5
+	StatementsOrderBy_TempBlksWritten              StatementsOrderBy = 6
+	StatementsOrderBy_WalBytes                     StatementsOrderBy = 7
+)
+
+// Enum value maps for StatementsOrderBy.
+var (
+	StatementsOrderBy_name = map[int32]string{
+		0: "StatementsOrderByUnspecified",
+		1: "TotalExecTime",
+		2: "Calls",
+		3: "MeanExecTime",
+		4: "Rows",
+		5: "SharedBlksRead",
+		6: "TempBlksWritten",
+		7: "WalBytes",
+	}
+	StatementsOrderBy_value = map[string]int32{
+		"StatementsOrderByUnspecified": 0,
+		"TotalExecTime":                1,
+		"Calls":                        2,
+		"MeanExecTime":                 3,
+		"Rows":                         4,
+		"SharedBlksRead":               5,
+		"TempBlksWritten":              6,
+		"WalBytes":                     7,
+	}
+)
+
+func (x StatementsOrderBy) Enum() *StatementsOrderBy {
+	p := new(StatementsOrderBy)
+	*p = x
+	return p
+}
+
+func (x StatementsOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementsOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[3].Descriptor()
+}
+
+func (StatementsOrderBy) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[3]
+}
+
+func (x StatementsOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementsOrderBy.Descriptor instead.
+func (StatementsOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{3}
+}
+
+type IndexRecommendationKind int32
+
+const (
+	IndexRecommendationKind_IndexRecommendationKindUnspecified IndexRecommendationKind = 0
+	// Never scanned since statistics were reset
+	IndexRecommendationKind_UnusedIndex IndexRecommendationKind = 1
+	// Same definition as another index of the table
+	IndexRecommendationKind_DuplicateIndex IndexRecommendationKind = 2
+	// Columns are a leading part of another btree index
+	IndexRecommendationKind_OverlappingIndex IndexRecommendationKind = 3
+	// Left by a failed concurrent build
+	IndexRecommendationKind_InvalidIndex IndexRecommendationKind = 4
+	IndexRecommendationKind_BloatedIndex IndexRecommendationKind = 5
+)
+
+// Enum value maps for IndexRecommendationKind.
+var (
+	IndexRecommendationKind_name = map[int32]string{
+		0: "IndexRecommendationKindUnspecified",
+		1: "UnusedIndex",
+		2: "DuplicateIndex",
+		3: "OverlappingIndex",
+		4: "InvalidIndex",
+		5: "BloatedIndex",
+	}
+	IndexRecommendationKind_value = map[string]int32{
+		"IndexRecommendationKindUnspecified": 0,
+		"UnusedIndex":                        1,
+		"DuplicateIndex":                     2,
+		"OverlappingIndex":                   3,
+		"InvalidIndex":                       4,
+		"BloatedIndex":                       5,
+	}
+)
+
+func (x IndexRecommendationKind) Enum() *IndexRecommendationKind {
+	p := new(IndexRecommendationKind)
+	*p = x
+	return p
+}
+
+func (x IndexRecommendationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexRecommendationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[4].Descriptor()
+}
+
+func (IndexRecommendationKind) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[4]
+}
+
+func (x IndexRecommendationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexRecommendationKind.Descriptor instead.
+func (IndexRecommendationKind) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{4}
+}
+
+type MaintenanceAction int32
+
+const (
+	MaintenanceAction_MaintenanceActionUnspecified MaintenanceAction = 0
+	MaintenanceAction_VacuumAnalyze                MaintenanceAction = 1
+	MaintenanceAction_AnalyzeOnly                  MaintenanceAction = 2
+	// REINDEX TABLE CONCURRENTLY for tables, REINDEX INDEX CONCURRENTLY for indexes
+	MaintenanceAction_ReindexConcurrently MaintenanceAction = 3
+)
+
+// Enum value maps for MaintenanceAction.
+var (
+	MaintenanceAction_name = map[int32]string{
+		0: "MaintenanceActionUnspecified",
+		1: "VacuumAnalyze",
+		2: "AnalyzeOnly",
+		3: "ReindexConcurrently",
+	}
+	MaintenanceAction_value = map[string]int32{
+		"MaintenanceActionUnspecified": 0,
+		"VacuumAnalyze":                1,
+		"AnalyzeOnly":                  2,
+		"ReindexConcurrently":          3,
+	}
+)
+
+func (x MaintenanceAction) Enum() *MaintenanceAction {
+	p := new(MaintenanceAction)
+	*p = x
+	return p
+}
+
+func (x MaintenanceAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[5].Descriptor()
+}
+
+func (MaintenanceAction) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[5]
+}
+
+func (x MaintenanceAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceAction.Descriptor instead.
+func (MaintenanceAction) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{5}
+}
+
+type MaintenanceJobStatus int32
+
+const (
+	MaintenanceJobStatus_MaintenanceJobStatusUnspecified MaintenanceJobStatus = 0
+	MaintenanceJobStatus_Running                         MaintenanceJobStatus = 1
+	MaintenanceJobStatus_Succeeded                       MaintenanceJobStatus = 2
+	MaintenanceJobStatus_Failed                          MaintenanceJobStatus = 3
+	MaintenanceJobStatus_Canceled                        MaintenanceJobStatus = 4
+)
+
+// Enum value maps for MaintenanceJobStatus.
+var (
+	MaintenanceJobStatus_name = map[int32]string{
+		0: "MaintenanceJobStatusUnspecified",
+		1: "Running",
+		2: "Succeeded",
+		3: "Failed",
+		4: "Canceled",
+	}
+	MaintenanceJobStatus_value = map[string]int32{
+		"MaintenanceJobStatusUnspecified": 0,
+		"Running":                         1,
+		"Succeeded":                       2,
+		"Failed":                          3,
+		"Canceled":                        4,
+	}
+)
+
+func (x MaintenanceJobStatus) Enum() *MaintenanceJobStatus {
+	p := new(MaintenanceJobStatus)
+	*p = x
+	return p
+}
+
+func (x MaintenanceJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_collector_collector_proto_enumTypes[6].Descriptor()
+}
+
+func (MaintenanceJobStatus) Type() protoreflect.EnumType {
+	return &file_collector_collector_proto_enumTypes[6]
+}
+
+func (x MaintenanceJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceJobStatus.Descriptor instead.
+func (MaintenanceJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{6}
+}
+
 type CollectKnobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,6 +519,13 @@ type CollectInternalMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Mode MetricsMode `protobuf:"varint,1,opt,name=mode,proto3,enum=collector.MetricsMode" json:"mode,omitempty"`
+	// When set, delta and rate are computed over this window instead of the interval since the previous call,
+	// at most 300 seconds
+	WindowSeconds uint32 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// Identifies the caller whose previous call deltas are computed against, the peer address when empty
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
 func (x *CollectInternalMetricsRequest) Reset() {
@@ -143,12 +560,35 @@ func (*CollectInternalMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *CollectInternalMetricsRequest) GetMode() MetricsMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MetricsMode_MetricsModeUnspecified
+}
+
+func (x *CollectInternalMetricsRequest) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *CollectInternalMetricsRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
 type CollectInternalMetricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Metrics []*CollectInternalMetricsResponse_Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// Interval delta and rate values cover, zero in raw mode
+	IntervalSeconds float32 `protobuf:"fixed32,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 }
 
 func (x *CollectInternalMetricsResponse) Reset() {
@@ -190,14 +630,30 @@ func (x *CollectInternalMetricsResponse) GetMetrics() []*CollectInternalMetricsR
 	return nil
 }
 
-type CollectExternalMetricsRequest struct {
+func (x *CollectInternalMetricsResponse) GetIntervalSeconds() float32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type StreamInternalMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Rounded to whole seconds, 10 seconds when not set. Messages are not sent more often
+	// than the collector samples the groups, whatever the interval
+	IntervalSeconds uint32 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// All groups the collector samples when empty, groups it does not sample fail with FAILED_PRECONDITION
+	Groups []MetricGroup `protobuf:"varint,2,rep,packed,name=groups,proto3,enum=collector.MetricGroup" json:"groups,omitempty"`
+	// In delta and rate modes counters cover the interval since the previous message,
+	// so the first message is sent after one interval
+	Mode MetricsMode `protobuf:"varint,3,opt,name=mode,proto3,enum=collector.MetricsMode" json:"mode,omitempty"`
 }
 
-func (x *CollectExternalMetricsRequest) Reset() {
-	*x = CollectExternalMetricsRequest{}
+func (x *StreamInternalMetricsRequest) Reset() {
+	*x = StreamInternalMetricsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_collector_collector_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -205,13 +661,13 @@ func (x *CollectExternalMetricsRequest) Reset() {
 	}
 }
 
-func (x *CollectExternalMetricsRequest) String() string {
+func (x *StreamInternalMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectExternalMetricsRequest) ProtoMessage() {}
+func (*StreamInternalMetricsRequest) ProtoMessage() {}
 
-func (x *CollectExternalMetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamInternalMetricsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -223,22 +679,45 @@ func (x *CollectExternalMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectExternalMetricsRequest.ProtoReflect.Descriptor instead.
-func (*CollectExternalMetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamInternalMetricsRequest.ProtoReflect.Descriptor instead.
+func (*StreamInternalMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{4}
 }
 
-type CollectExternalMetricsResponse struct {
+func (x *StreamInternalMetricsRequest) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *StreamInternalMetricsRequest) GetGroups() []MetricGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *StreamInternalMetricsRequest) GetMode() MetricsMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MetricsMode_MetricsModeUnspecified
+}
+
+type StreamInternalMetricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tps     float32 `protobuf:"fixed32,1,opt,name=tps,proto3" json:"tps,omitempty"`
-	Latency float32 `protobuf:"fixed32,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	TakenAt *timestamppb.Timestamp                   `protobuf:"bytes,1,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	Metrics []*CollectInternalMetricsResponse_Metric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// Interval delta and rate values cover, zero in raw mode
+	IntervalSeconds float32 `protobuf:"fixed32,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 }
 
-func (x *CollectExternalMetricsResponse) Reset() {
-	*x = CollectExternalMetricsResponse{}
+func (x *StreamInternalMetricsResponse) Reset() {
+	*x = StreamInternalMetricsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_collector_collector_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,13 +725,13 @@ func (x *CollectExternalMetricsResponse) Reset() {
 	}
 }
 
-func (x *CollectExternalMetricsResponse) String() string {
+func (x *StreamInternalMetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectExternalMetricsResponse) ProtoMessage() {}
+func (*StreamInternalMetricsResponse) ProtoMessage() {}
 
-func (x *CollectExternalMetricsResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamInternalMetricsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,33 +743,53 @@ func (x *CollectExternalMetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectExternalMetricsResponse.ProtoReflect.Descriptor instead.
-func (*CollectExternalMetricsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamInternalMetricsResponse.ProtoReflect.Descriptor instead.
+func (*StreamInternalMetricsResponse) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CollectExternalMetricsResponse) GetTps() float32 {
+func (x *StreamInternalMetricsResponse) GetTakenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tps
+		return x.TakenAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CollectExternalMetricsResponse) GetLatency() float32 {
+func (x *StreamInternalMetricsResponse) GetMetrics() []*CollectInternalMetricsResponse_Metric {
 	if x != nil {
-		return x.Latency
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *StreamInternalMetricsResponse) GetIntervalSeconds() float32 {
+	if x != nil {
+		return x.IntervalSeconds
 	}
 	return 0
 }
 
-type InitLoadRequest struct {
+// Fields that are not set are taken from the pgbench section of the collector config
+type CollectExternalMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Built-in scripts run with -b: tpcb-like, simple-update or select-only.
+	// Builtins and scripts replace the configured ones together, pgbench runs tpcb-like when none is set
+	Builtins []*CollectExternalMetricsRequest_Script `protobuf:"bytes,1,rep,name=builtins,proto3" json:"builtins,omitempty"`
+	// Custom script files run with -f
+	Scripts []*CollectExternalMetricsRequest_Script `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// Query mode passed with -M: simple, extended or prepared
+	QueryMode string `protobuf:"bytes,3,opt,name=query_mode,json=queryMode,proto3" json:"query_mode,omitempty"`
+	// Target transactions per second passed with -R
+	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Variables passed with -D, merged with the configured ones
+	Variables map[string]string `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *InitLoadRequest) Reset() {
-	*x = InitLoadRequest{}
+func (x *CollectExternalMetricsRequest) Reset() {
+	*x = CollectExternalMetricsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_collector_collector_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,13 +797,13 @@ func (x *InitLoadRequest) Reset() {
 	}
 }
 
-func (x *InitLoadRequest) String() string {
+func (x *CollectExternalMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitLoadRequest) ProtoMessage() {}
+func (*CollectExternalMetricsRequest) ProtoMessage() {}
 
-func (x *InitLoadRequest) ProtoReflect() protoreflect.Message {
+func (x *CollectExternalMetricsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -316,33 +815,79 @@ func (x *InitLoadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitLoadRequest.ProtoReflect.Descriptor instead.
-func (*InitLoadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectExternalMetricsRequest.ProtoReflect.Descriptor instead.
+func (*CollectExternalMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{6}
 }
 
-type InitLoadResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CollectExternalMetricsRequest) GetBuiltins() []*CollectExternalMetricsRequest_Script {
+	if x != nil {
+		return x.Builtins
+	}
+	return nil
 }
 
-func (x *InitLoadResponse) Reset() {
-	*x = InitLoadResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_collector_collector_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CollectExternalMetricsRequest) GetScripts() []*CollectExternalMetricsRequest_Script {
+	if x != nil {
+		return x.Scripts
 	}
+	return nil
 }
 
-func (x *InitLoadResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CollectExternalMetricsRequest) GetQueryMode() string {
+	if x != nil {
+		return x.QueryMode
+	}
+	return ""
 }
 
-func (*InitLoadResponse) ProtoMessage() {}
+func (x *CollectExternalMetricsRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
 
-func (x *InitLoadResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectExternalMetricsRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type CollectExternalMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tps float32 `protobuf:"fixed32,1,opt,name=tps,proto3" json:"tps,omitempty"`
+	// Average transaction latency in milliseconds
+	Latency float32 `protobuf:"fixed32,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Latency percentiles in milliseconds, reported by the native load generator only
+	LatencyP50   float32 `protobuf:"fixed32,3,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP95   float32 `protobuf:"fixed32,4,opt,name=latency_p95,json=latencyP95,proto3" json:"latency_p95,omitempty"`
+	LatencyP99   float32 `protobuf:"fixed32,5,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	Transactions uint64  `protobuf:"varint,6,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	// Failed transactions, pgbench reports them since PostgreSQL 15
+	Errors uint64 `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CollectExternalMetricsResponse) Reset() {
+	*x = CollectExternalMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectExternalMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectExternalMetricsResponse) ProtoMessage() {}
+
+func (x *CollectExternalMetricsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -354,21 +899,68 @@ func (x *InitLoadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitLoadResponse.ProtoReflect.Descriptor instead.
-func (*InitLoadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectExternalMetricsResponse.ProtoReflect.Descriptor instead.
+func (*CollectExternalMetricsResponse) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{7}
 }
 
-type SetKnobsRequest struct {
+func (x *CollectExternalMetricsResponse) GetTps() float32 {
+	if x != nil {
+		return x.Tps
+	}
+	return 0
+}
+
+func (x *CollectExternalMetricsResponse) GetLatency() float32 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *CollectExternalMetricsResponse) GetLatencyP50() float32 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *CollectExternalMetricsResponse) GetLatencyP95() float32 {
+	if x != nil {
+		return x.LatencyP95
+	}
+	return 0
+}
+
+func (x *CollectExternalMetricsResponse) GetLatencyP99() float32 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
+func (x *CollectExternalMetricsResponse) GetTransactions() uint64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *CollectExternalMetricsResponse) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+type InitLoadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Knobs []*SetKnobsRequest_Knob `protobuf:"bytes,1,rep,name=knobs,proto3" json:"knobs,omitempty"`
 }
 
-func (x *SetKnobsRequest) Reset() {
-	*x = SetKnobsRequest{}
+func (x *InitLoadRequest) Reset() {
+	*x = InitLoadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_collector_collector_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,13 +968,13 @@ func (x *SetKnobsRequest) Reset() {
 	}
 }
 
-func (x *SetKnobsRequest) String() string {
+func (x *InitLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetKnobsRequest) ProtoMessage() {}
+func (*InitLoadRequest) ProtoMessage() {}
 
-func (x *SetKnobsRequest) ProtoReflect() protoreflect.Message {
+func (x *InitLoadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,26 +986,19 @@ func (x *SetKnobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetKnobsRequest.ProtoReflect.Descriptor instead.
-func (*SetKnobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitLoadRequest.ProtoReflect.Descriptor instead.
+func (*InitLoadRequest) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SetKnobsRequest) GetKnobs() []*SetKnobsRequest_Knob {
-	if x != nil {
-		return x.Knobs
-	}
-	return nil
-}
-
-type SetKnobsResponse struct {
+type InitLoadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetKnobsResponse) Reset() {
-	*x = SetKnobsResponse{}
+func (x *InitLoadResponse) Reset() {
+	*x = InitLoadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_collector_collector_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,13 +1006,13 @@ func (x *SetKnobsResponse) Reset() {
 	}
 }
 
-func (x *SetKnobsResponse) String() string {
+func (x *InitLoadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetKnobsResponse) ProtoMessage() {}
+func (*InitLoadResponse) ProtoMessage() {}
 
-func (x *SetKnobsResponse) ProtoReflect() protoreflect.Message {
+func (x *InitLoadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,29 +1024,21 @@ func (x *SetKnobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetKnobsResponse.ProtoReflect.Descriptor instead.
-func (*SetKnobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitLoadResponse.ProtoReflect.Descriptor instead.
+func (*InitLoadResponse) Descriptor() ([]byte, []int) {
 	return file_collector_collector_proto_rawDescGZIP(), []int{9}
 }
 
-type CollectKnobsResponse_Knob struct {
+type SetKnobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	MinValue float32 `protobuf:"fixed32,2,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
-	MaxValue float32 `protobuf:"fixed32,3,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	// Types that are assignable to Value:
-	//
-	//	*CollectKnobsResponse_Knob_StrValue
-	//	*CollectKnobsResponse_Knob_FloatValue
-	//	*CollectKnobsResponse_Knob_BoolValue
-	Value isCollectKnobsResponse_Knob_Value `protobuf_oneof:"value"`
+	Knobs []*SetKnobsRequest_Knob `protobuf:"bytes,1,rep,name=knobs,proto3" json:"knobs,omitempty"`
 }
 
-func (x *CollectKnobsResponse_Knob) Reset() {
-	*x = CollectKnobsResponse_Knob{}
+func (x *SetKnobsRequest) Reset() {
+	*x = SetKnobsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_collector_collector_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -469,13 +1046,13 @@ func (x *CollectKnobsResponse_Knob) Reset() {
 	}
 }
 
-func (x *CollectKnobsResponse_Knob) String() string {
+func (x *SetKnobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectKnobsResponse_Knob) ProtoMessage() {}
+func (*SetKnobsRequest) ProtoMessage() {}
 
-func (x *CollectKnobsResponse_Knob) ProtoReflect() protoreflect.Message {
+func (x *SetKnobsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_collector_collector_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,114 +1064,92 @@ func (x *CollectKnobsResponse_Knob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectKnobsResponse_Knob.ProtoReflect.Descriptor instead.
-func (*CollectKnobsResponse_Knob) Descriptor() ([]byte, []int) {
-	return file_collector_collector_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use SetKnobsRequest.ProtoReflect.Descriptor instead.
+func (*SetKnobsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CollectKnobsResponse_Knob) GetName() string {
+func (x *SetKnobsRequest) GetKnobs() []*SetKnobsRequest_Knob {
 	if x != nil {
-		return x.Name
+		return x.Knobs
 	}
-	return ""
+	return nil
 }
 
-func (x *CollectKnobsResponse_Knob) GetMinValue() float32 {
-	if x != nil {
-		return x.MinValue
-	}
-	return 0
-}
+type SetKnobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CollectKnobsResponse_Knob) GetMaxValue() float32 {
-	if x != nil {
-		return x.MaxValue
-	}
-	return 0
+	Knobs []*SetKnobsResponse_Knob `protobuf:"bytes,1,rep,name=knobs,proto3" json:"knobs,omitempty"`
 }
 
-func (m *CollectKnobsResponse_Knob) GetValue() isCollectKnobsResponse_Knob_Value {
-	if m != nil {
-		return m.Value
+func (x *SetKnobsResponse) Reset() {
+	*x = SetKnobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CollectKnobsResponse_Knob) GetStrValue() string {
-	if x, ok := x.GetValue().(*CollectKnobsResponse_Knob_StrValue); ok {
-		return x.StrValue
-	}
-	return ""
+func (x *SetKnobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CollectKnobsResponse_Knob) GetFloatValue() float32 {
-	if x, ok := x.GetValue().(*CollectKnobsResponse_Knob_FloatValue); ok {
-		return x.FloatValue
-	}
-	return 0
-}
+func (*SetKnobsResponse) ProtoMessage() {}
 
-func (x *CollectKnobsResponse_Knob) GetBoolValue() bool {
-	if x, ok := x.GetValue().(*CollectKnobsResponse_Knob_BoolValue); ok {
-		return x.BoolValue
+func (x *SetKnobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
-}
-
-type isCollectKnobsResponse_Knob_Value interface {
-	isCollectKnobsResponse_Knob_Value()
-}
-
-type CollectKnobsResponse_Knob_StrValue struct {
-	StrValue string `protobuf:"bytes,4,opt,name=str_value,json=strValue,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type CollectKnobsResponse_Knob_FloatValue struct {
-	FloatValue float32 `protobuf:"fixed32,5,opt,name=float_value,json=floatValue,proto3,oneof"`
+// Deprecated: Use SetKnobsResponse.ProtoReflect.Descriptor instead.
+func (*SetKnobsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{11}
 }
 
-type CollectKnobsResponse_Knob_BoolValue struct {
-	BoolValue bool `protobuf:"varint,6,opt,name=bool_value,json=boolValue,proto3,oneof"`
+func (x *SetKnobsResponse) GetKnobs() []*SetKnobsResponse_Knob {
+	if x != nil {
+		return x.Knobs
+	}
+	return nil
 }
 
-func (*CollectKnobsResponse_Knob_StrValue) isCollectKnobsResponse_Knob_Value() {}
-
-func (*CollectKnobsResponse_Knob_FloatValue) isCollectKnobsResponse_Knob_Value() {}
-
-func (*CollectKnobsResponse_Knob_BoolValue) isCollectKnobsResponse_Knob_Value() {}
-
-type CollectInternalMetricsResponse_Metric struct {
+type KnobSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Value:
-	//
-	//	*CollectInternalMetricsResponse_Metric_StrValue
-	//	*CollectInternalMetricsResponse_Metric_FloatValue
-	//	*CollectInternalMetricsResponse_Metric_BoolValue
-	Value isCollectInternalMetricsResponse_Metric_Value `protobuf_oneof:"value"`
-	Scope string                                        `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	Name      string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Knobs     []*CollectKnobsResponse_Knob `protobuf:"bytes,3,rep,name=knobs,proto3" json:"knobs,omitempty"`
 }
 
-func (x *CollectInternalMetricsResponse_Metric) Reset() {
-	*x = CollectInternalMetricsResponse_Metric{}
+func (x *KnobSnapshot) Reset() {
+	*x = KnobSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collector_collector_proto_msgTypes[11]
+		mi := &file_collector_collector_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CollectInternalMetricsResponse_Metric) String() string {
+func (x *KnobSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectInternalMetricsResponse_Metric) ProtoMessage() {}
+func (*KnobSnapshot) ProtoMessage() {}
 
-func (x *CollectInternalMetricsResponse_Metric) ProtoReflect() protoreflect.Message {
-	mi := &file_collector_collector_proto_msgTypes[11]
+func (x *KnobSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,104 +1160,189 @@ func (x *CollectInternalMetricsResponse_Metric) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectInternalMetricsResponse_Metric.ProtoReflect.Descriptor instead.
-func (*CollectInternalMetricsResponse_Metric) Descriptor() ([]byte, []int) {
-	return file_collector_collector_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use KnobSnapshot.ProtoReflect.Descriptor instead.
+func (*KnobSnapshot) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CollectInternalMetricsResponse_Metric) GetName() string {
+func (x *KnobSnapshot) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (m *CollectInternalMetricsResponse_Metric) GetValue() isCollectInternalMetricsResponse_Metric_Value {
-	if m != nil {
-		return m.Value
+func (x *KnobSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *CollectInternalMetricsResponse_Metric) GetStrValue() string {
-	if x, ok := x.GetValue().(*CollectInternalMetricsResponse_Metric_StrValue); ok {
-		return x.StrValue
+func (x *KnobSnapshot) GetKnobs() []*CollectKnobsResponse_Knob {
+	if x != nil {
+		return x.Knobs
 	}
-	return ""
+	return nil
 }
 
-func (x *CollectInternalMetricsResponse_Metric) GetFloatValue() float32 {
-	if x, ok := x.GetValue().(*CollectInternalMetricsResponse_Metric_FloatValue); ok {
-		return x.FloatValue
+type CreateKnobSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateKnobSnapshotRequest) Reset() {
+	*x = CreateKnobSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CollectInternalMetricsResponse_Metric) GetBoolValue() bool {
-	if x, ok := x.GetValue().(*CollectInternalMetricsResponse_Metric_BoolValue); ok {
-		return x.BoolValue
+func (x *CreateKnobSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKnobSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateKnobSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CollectInternalMetricsResponse_Metric) GetScope() string {
+// Deprecated: Use CreateKnobSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateKnobSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateKnobSnapshotRequest) GetName() string {
 	if x != nil {
-		return x.Scope
+		return x.Name
 	}
 	return ""
 }
 
-type isCollectInternalMetricsResponse_Metric_Value interface {
-	isCollectInternalMetricsResponse_Metric_Value()
+type CreateKnobSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *KnobSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-type CollectInternalMetricsResponse_Metric_StrValue struct {
-	StrValue string `protobuf:"bytes,2,opt,name=str_value,json=strValue,proto3,oneof"`
+func (x *CreateKnobSnapshotResponse) Reset() {
+	*x = CreateKnobSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type CollectInternalMetricsResponse_Metric_FloatValue struct {
-	FloatValue float32 `protobuf:"fixed32,3,opt,name=float_value,json=floatValue,proto3,oneof"`
+func (x *CreateKnobSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CollectInternalMetricsResponse_Metric_BoolValue struct {
-	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+func (*CreateKnobSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateKnobSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*CollectInternalMetricsResponse_Metric_StrValue) isCollectInternalMetricsResponse_Metric_Value() {
+// Deprecated: Use CreateKnobSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateKnobSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{14}
 }
 
-func (*CollectInternalMetricsResponse_Metric_FloatValue) isCollectInternalMetricsResponse_Metric_Value() {
+func (x *CreateKnobSnapshotResponse) GetSnapshot() *KnobSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
 }
 
-func (*CollectInternalMetricsResponse_Metric_BoolValue) isCollectInternalMetricsResponse_Metric_Value() {
+type ListKnobSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type SetKnobsRequest_Knob struct {
+func (x *ListKnobSnapshotsRequest) Reset() {
+	*x = ListKnobSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKnobSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnobSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListKnobSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnobSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListKnobSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{15}
+}
+
+type ListKnobSnapshotsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value float32 `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Snapshots []*KnobSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
-func (x *SetKnobsRequest_Knob) Reset() {
-	*x = SetKnobsRequest_Knob{}
+func (x *ListKnobSnapshotsResponse) Reset() {
+	*x = ListKnobSnapshotsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collector_collector_proto_msgTypes[12]
+		mi := &file_collector_collector_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetKnobsRequest_Knob) String() string {
+func (x *ListKnobSnapshotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetKnobsRequest_Knob) ProtoMessage() {}
+func (*ListKnobSnapshotsResponse) ProtoMessage() {}
 
-func (x *SetKnobsRequest_Knob) ProtoReflect() protoreflect.Message {
-	mi := &file_collector_collector_proto_msgTypes[12]
+func (x *ListKnobSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,176 +1353,5499 @@ func (x *SetKnobsRequest_Knob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetKnobsRequest_Knob.ProtoReflect.Descriptor instead.
-func (*SetKnobsRequest_Knob) Descriptor() ([]byte, []int) {
-	return file_collector_collector_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use ListKnobSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListKnobSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SetKnobsRequest_Knob) GetName() string {
+func (x *ListKnobSnapshotsResponse) GetSnapshots() []*KnobSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type RestoreKnobSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RestoreKnobSnapshotRequest) Reset() {
+	*x = RestoreKnobSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreKnobSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreKnobSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreKnobSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreKnobSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreKnobSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreKnobSnapshotRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetKnobsRequest_Knob) GetValue() float32 {
+type RestoreKnobSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only knobs that differed from the snapshot are listed
+	Knobs []*SetKnobsResponse_Knob `protobuf:"bytes,1,rep,name=knobs,proto3" json:"knobs,omitempty"`
+}
+
+func (x *RestoreKnobSnapshotResponse) Reset() {
+	*x = RestoreKnobSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreKnobSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreKnobSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreKnobSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreKnobSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreKnobSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreKnobSnapshotResponse) GetKnobs() []*SetKnobsResponse_Knob {
 	if x != nil {
-		return x.Value
+		return x.Knobs
 	}
-	return 0
+	return nil
 }
 
-var File_collector_collector_proto protoreflect.FileDescriptor
+type ResetKnobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-var file_collector_collector_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x95, 0x02,
-	0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52, 0x05, 0x6b, 0x6e, 0x6f,
-	0x62, 0x73, 0x1a, 0xc0, 0x01, 0x0a, 0x04, 0x4b, 0x6e, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
-	0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52,
-	0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62,
-	0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x02, 0x0a, 0x1e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x9e, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f,
-	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x07, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x74, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c,
-	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6c, 0x61,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74,
-	0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x0f,
-	0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x35, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52,
-	0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x1a, 0x30, 0x0a, 0x04, 0x4b, 0x6e, 0x6f, 0x62, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4b,
-	0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc4, 0x03, 0x0a,
-	0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x49, 0x6e, 0x69,
-	0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e,
-	0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
-	0x0a, 0x08, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x08, 0x5a, 0x06, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ResetKnobsRequest) Reset() {
+	*x = ResetKnobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_collector_collector_proto_rawDescOnce sync.Once
-	file_collector_collector_proto_rawDescData = file_collector_collector_proto_rawDesc
-)
+func (x *ResetKnobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_collector_collector_proto_rawDescGZIP() []byte {
-	file_collector_collector_proto_rawDescOnce.Do(func() {
-		file_collector_collector_proto_rawDescData = protoimpl.X.CompressGZIP(file_collector_collector_proto_rawDescData)
-	})
-	return file_collector_collector_proto_rawDescData
+func (*ResetKnobsRequest) ProtoMessage() {}
+
+func (x *ResetKnobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_collector_collector_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_collector_collector_proto_goTypes = []interface{}{
-	(*CollectKnobsRequest)(nil),                   // 0: collector.CollectKnobsRequest
-	(*CollectKnobsResponse)(nil),                  // 1: collector.CollectKnobsResponse
-	(*CollectInternalMetricsRequest)(nil),         // 2: collector.CollectInternalMetricsRequest
-	(*CollectInternalMetricsResponse)(nil),        // 3: collector.CollectInternalMetricsResponse
-	(*CollectExternalMetricsRequest)(nil),         // 4: collector.CollectExternalMetricsRequest
-	(*CollectExternalMetricsResponse)(nil),        // 5: collector.CollectExternalMetricsResponse
-	(*InitLoadRequest)(nil),                       // 6: collector.InitLoadRequest
-	(*InitLoadResponse)(nil),                      // 7: collector.InitLoadResponse
-	(*SetKnobsRequest)(nil),                       // 8: collector.SetKnobsRequest
-	(*SetKnobsResponse)(nil),                      // 9: collector.SetKnobsResponse
-	(*CollectKnobsResponse_Knob)(nil),             // 10: collector.CollectKnobsResponse.Knob
-	(*CollectInternalMetricsResponse_Metric)(nil), // 11: collector.CollectInternalMetricsResponse.Metric
-	(*SetKnobsRequest_Knob)(nil),                  // 12: collector.SetKnobsRequest.Knob
+// Deprecated: Use ResetKnobsRequest.ProtoReflect.Descriptor instead.
+func (*ResetKnobsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{19}
 }
-var file_collector_collector_proto_depIdxs = []int32{
-	10, // 0: collector.CollectKnobsResponse.knobs:type_name -> collector.CollectKnobsResponse.Knob
-	11, // 1: collector.CollectInternalMetricsResponse.metrics:type_name -> collector.CollectInternalMetricsResponse.Metric
-	12, // 2: collector.SetKnobsRequest.knobs:type_name -> collector.SetKnobsRequest.Knob
-	0,  // 3: collector.Collector.CollectKnobs:input_type -> collector.CollectKnobsRequest
-	2,  // 4: collector.Collector.CollectInternalMetrics:input_type -> collector.CollectInternalMetricsRequest
-	4,  // 5: collector.Collector.CollectExternalMetrics:input_type -> collector.CollectExternalMetricsRequest
-	6,  // 6: collector.Collector.InitLoad:input_type -> collector.InitLoadRequest
-	8,  // 7: collector.Collector.SetKnobs:input_type -> collector.SetKnobsRequest
-	1,  // 8: collector.Collector.CollectKnobs:output_type -> collector.CollectKnobsResponse
-	3,  // 9: collector.Collector.CollectInternalMetrics:output_type -> collector.CollectInternalMetricsResponse
-	5,  // 10: collector.Collector.CollectExternalMetrics:output_type -> collector.CollectExternalMetricsResponse
-	7,  // 11: collector.Collector.InitLoad:output_type -> collector.InitLoadResponse
-	9,  // 12: collector.Collector.SetKnobs:output_type -> collector.SetKnobsResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+type ResetKnobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Knobs that take the reset value only after server restart
+	PendingRestart []string `protobuf:"bytes,1,rep,name=pending_restart,json=pendingRestart,proto3" json:"pending_restart,omitempty"`
 }
 
-func init() { file_collector_collector_proto_init() }
-func file_collector_collector_proto_init() {
-	if File_collector_collector_proto != nil {
-		return
+func (x *ResetKnobsResponse) Reset() {
+	*x = ResetKnobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_collector_collector_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectKnobsRequest); i {
+}
+
+func (x *ResetKnobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetKnobsResponse) ProtoMessage() {}
+
+func (x *ResetKnobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetKnobsResponse.ProtoReflect.Descriptor instead.
+func (*ResetKnobsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResetKnobsResponse) GetPendingRestart() []string {
+	if x != nil {
+		return x.PendingRestart
+	}
+	return nil
+}
+
+type CollectTopStatementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of statements to return, 10 by default, at most 1000
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Statements are sorted in descending order, total_exec_time by default
+	OrderBy StatementsOrderBy `protobuf:"varint,2,opt,name=order_by,json=orderBy,proto3,enum=collector.StatementsOrderBy" json:"order_by,omitempty"`
+}
+
+func (x *CollectTopStatementsRequest) Reset() {
+	*x = CollectTopStatementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectTopStatementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectTopStatementsRequest) ProtoMessage() {}
+
+func (x *CollectTopStatementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectTopStatementsRequest.ProtoReflect.Descriptor instead.
+func (*CollectTopStatementsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CollectTopStatementsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsRequest) GetOrderBy() StatementsOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return StatementsOrderBy_StatementsOrderByUnspecified
+}
+
+type CollectTopStatementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statements []*CollectTopStatementsResponse_Statement `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+}
+
+func (x *CollectTopStatementsResponse) Reset() {
+	*x = CollectTopStatementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectTopStatementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectTopStatementsResponse) ProtoMessage() {}
+
+func (x *CollectTopStatementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectTopStatementsResponse.ProtoReflect.Descriptor instead.
+func (*CollectTopStatementsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CollectTopStatementsResponse) GetStatements() []*CollectTopStatementsResponse_Statement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+type BlockingNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid   int64  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	User  string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// pg_stat_activity.state
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// Lock the backend waits for, empty for roots that do not wait
+	LockMode   string  `protobuf:"bytes,5,opt,name=lock_mode,json=lockMode,proto3" json:"lock_mode,omitempty"`
+	LockType   string  `protobuf:"bytes,6,opt,name=lock_type,json=lockType,proto3" json:"lock_type,omitempty"`
+	Relation   string  `protobuf:"bytes,7,opt,name=relation,proto3" json:"relation,omitempty"`
+	WaitTimeMs float64 `protobuf:"fixed64,8,opt,name=wait_time_ms,json=waitTimeMs,proto3" json:"wait_time_ms,omitempty"`
+	// Backends waiting for locks of this backend
+	Blocked []*BlockingNode `protobuf:"bytes,9,rep,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *BlockingNode) Reset() {
+	*x = BlockingNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockingNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingNode) ProtoMessage() {}
+
+func (x *BlockingNode) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingNode.ProtoReflect.Descriptor instead.
+func (*BlockingNode) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BlockingNode) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *BlockingNode) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *BlockingNode) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *BlockingNode) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BlockingNode) GetLockMode() string {
+	if x != nil {
+		return x.LockMode
+	}
+	return ""
+}
+
+func (x *BlockingNode) GetLockType() string {
+	if x != nil {
+		return x.LockType
+	}
+	return ""
+}
+
+func (x *BlockingNode) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *BlockingNode) GetWaitTimeMs() float64 {
+	if x != nil {
+		return x.WaitTimeMs
+	}
+	return 0
+}
+
+func (x *BlockingNode) GetBlocked() []*BlockingNode {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+type CollectBlockingTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CollectBlockingTreeRequest) Reset() {
+	*x = CollectBlockingTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectBlockingTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectBlockingTreeRequest) ProtoMessage() {}
+
+func (x *CollectBlockingTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectBlockingTreeRequest.ProtoReflect.Descriptor instead.
+func (*CollectBlockingTreeRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{24}
+}
+
+type CollectBlockingTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roots []*BlockingNode `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *CollectBlockingTreeResponse) Reset() {
+	*x = CollectBlockingTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectBlockingTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectBlockingTreeResponse) ProtoMessage() {}
+
+func (x *CollectBlockingTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectBlockingTreeResponse.ProtoReflect.Descriptor instead.
+func (*CollectBlockingTreeResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CollectBlockingTreeResponse) GetRoots() []*BlockingNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type CollectWaitEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Period to aggregate samples over, the configured window by default
+	WindowSeconds uint32 `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (x *CollectWaitEventsRequest) Reset() {
+	*x = CollectWaitEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectWaitEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectWaitEventsRequest) ProtoMessage() {}
+
+func (x *CollectWaitEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectWaitEventsRequest.ProtoReflect.Descriptor instead.
+func (*CollectWaitEventsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CollectWaitEventsRequest) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+type CollectWaitEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Samples int64                  `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Number of non-idle client sessions over all samples
+	Sessions int64 `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// Sorted by count in descending order
+	Events []*CollectWaitEventsResponse_Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *CollectWaitEventsResponse) Reset() {
+	*x = CollectWaitEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectWaitEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectWaitEventsResponse) ProtoMessage() {}
+
+func (x *CollectWaitEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectWaitEventsResponse.ProtoReflect.Descriptor instead.
+func (*CollectWaitEventsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CollectWaitEventsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CollectWaitEventsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CollectWaitEventsResponse) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *CollectWaitEventsResponse) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *CollectWaitEventsResponse) GetEvents() []*CollectWaitEventsResponse_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CollectReplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CollectReplicationRequest) Reset() {
+	*x = CollectReplicationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectReplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectReplicationRequest) ProtoMessage() {}
+
+func (x *CollectReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectReplicationRequest.ProtoReflect.Descriptor instead.
+func (*CollectReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{28}
+}
+
+type CollectReplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// True on standbys
+	InRecovery bool                                  `protobuf:"varint,1,opt,name=in_recovery,json=inRecovery,proto3" json:"in_recovery,omitempty"`
+	Replicas   []*CollectReplicationResponse_Replica `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	Slots      []*CollectReplicationResponse_Slot    `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *CollectReplicationResponse) Reset() {
+	*x = CollectReplicationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectReplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectReplicationResponse) ProtoMessage() {}
+
+func (x *CollectReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectReplicationResponse.ProtoReflect.Descriptor instead.
+func (*CollectReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CollectReplicationResponse) GetInRecovery() bool {
+	if x != nil {
+		return x.InRecovery
+	}
+	return false
+}
+
+func (x *CollectReplicationResponse) GetReplicas() []*CollectReplicationResponse_Replica {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *CollectReplicationResponse) GetSlots() []*CollectReplicationResponse_Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type CollectAutovacuumStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Transactions open for longer are reported, 300 by default
+	LongTransactionSeconds uint32 `protobuf:"varint,1,opt,name=long_transaction_seconds,json=longTransactionSeconds,proto3" json:"long_transaction_seconds,omitempty"`
+}
+
+func (x *CollectAutovacuumStateRequest) Reset() {
+	*x = CollectAutovacuumStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAutovacuumStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAutovacuumStateRequest) ProtoMessage() {}
+
+func (x *CollectAutovacuumStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAutovacuumStateRequest.ProtoReflect.Descriptor instead.
+func (*CollectAutovacuumStateRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CollectAutovacuumStateRequest) GetLongTransactionSeconds() uint32 {
+	if x != nil {
+		return x.LongTransactionSeconds
+	}
+	return 0
+}
+
+type CollectAutovacuumStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseName string                                     `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	Settings     *CollectAutovacuumStateResponse_Settings   `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Relations    []*CollectAutovacuumStateResponse_Relation `protobuf:"bytes,3,rep,name=relations,proto3" json:"relations,omitempty"`
+	// Oldest first
+	LongRunningTransactions []*CollectAutovacuumStateResponse_Transaction `protobuf:"bytes,4,rep,name=long_running_transactions,json=longRunningTransactions,proto3" json:"long_running_transactions,omitempty"`
+}
+
+func (x *CollectAutovacuumStateResponse) Reset() {
+	*x = CollectAutovacuumStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAutovacuumStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAutovacuumStateResponse) ProtoMessage() {}
+
+func (x *CollectAutovacuumStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAutovacuumStateResponse.ProtoReflect.Descriptor instead.
+func (*CollectAutovacuumStateResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CollectAutovacuumStateResponse) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *CollectAutovacuumStateResponse) GetSettings() *CollectAutovacuumStateResponse_Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse) GetRelations() []*CollectAutovacuumStateResponse_Relation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse) GetLongRunningTransactions() []*CollectAutovacuumStateResponse_Transaction {
+	if x != nil {
+		return x.LongRunningTransactions
+	}
+	return nil
+}
+
+type RecommendTableOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecommendTableOptionsRequest) Reset() {
+	*x = RecommendTableOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendTableOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendTableOptionsRequest) ProtoMessage() {}
+
+func (x *RecommendTableOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendTableOptionsRequest.ProtoReflect.Descriptor instead.
+func (*RecommendTableOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{32}
+}
+
+type RecommendTableOptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*RecommendTableOptionsResponse_Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *RecommendTableOptionsResponse) Reset() {
+	*x = RecommendTableOptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendTableOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendTableOptionsResponse) ProtoMessage() {}
+
+func (x *RecommendTableOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendTableOptionsResponse.ProtoReflect.Descriptor instead.
+func (*RecommendTableOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RecommendTableOptionsResponse) GetTables() []*RecommendTableOptionsResponse_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type SetTableOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*SetTableOptionsRequest_Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *SetTableOptionsRequest) Reset() {
+	*x = SetTableOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTableOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTableOptionsRequest) ProtoMessage() {}
+
+func (x *SetTableOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTableOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetTableOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetTableOptionsRequest) GetTables() []*SetTableOptionsRequest_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type SetTableOptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options []*SetTableOptionsResponse_Option `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *SetTableOptionsResponse) Reset() {
+	*x = SetTableOptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTableOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTableOptionsResponse) ProtoMessage() {}
+
+func (x *SetTableOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTableOptionsResponse.ProtoReflect.Descriptor instead.
+func (*SetTableOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTableOptionsResponse) GetOptions() []*SetTableOptionsResponse_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type RecommendIndexesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecommendIndexesRequest) Reset() {
+	*x = RecommendIndexesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendIndexesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendIndexesRequest) ProtoMessage() {}
+
+func (x *RecommendIndexesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendIndexesRequest.ProtoReflect.Descriptor instead.
+func (*RecommendIndexesRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{36}
+}
+
+type RecommendIndexesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Not set when statistics of the database were never reset
+	StatsReset      *timestamppb.Timestamp                     `protobuf:"bytes,1,opt,name=stats_reset,json=statsReset,proto3" json:"stats_reset,omitempty"`
+	Recommendations []*RecommendIndexesResponse_Recommendation `protobuf:"bytes,2,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *RecommendIndexesResponse) Reset() {
+	*x = RecommendIndexesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendIndexesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendIndexesResponse) ProtoMessage() {}
+
+func (x *RecommendIndexesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendIndexesResponse.ProtoReflect.Descriptor instead.
+func (*RecommendIndexesResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RecommendIndexesResponse) GetStatsReset() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatsReset
+	}
+	return nil
+}
+
+func (x *RecommendIndexesResponse) GetRecommendations() []*RecommendIndexesResponse_Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type MaintenanceJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action       MaintenanceAction      `protobuf:"varint,2,opt,name=action,proto3,enum=collector.MaintenanceAction" json:"action,omitempty"`
+	SchemaName   string                 `protobuf:"bytes,3,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	RelationName string                 `protobuf:"bytes,4,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	Status       MaintenanceJobStatus   `protobuf:"varint,5,opt,name=status,proto3,enum=collector.MaintenanceJobStatus" json:"status,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Not set for running jobs
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Running time so far for running jobs
+	DurationSeconds float64 `protobuf:"fixed64,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Progress of running jobs from pg_stat_progress_* views
+	Phase       string  `protobuf:"bytes,9,opt,name=phase,proto3" json:"phase,omitempty"`
+	BlocksTotal float64 `protobuf:"fixed64,10,opt,name=blocks_total,json=blocksTotal,proto3" json:"blocks_total,omitempty"`
+	BlocksDone  float64 `protobuf:"fixed64,11,opt,name=blocks_done,json=blocksDone,proto3" json:"blocks_done,omitempty"`
+	// Total size of the relation including indexes and TOAST
+	SizeBeforeBytes float64 `protobuf:"fixed64,12,opt,name=size_before_bytes,json=sizeBeforeBytes,proto3" json:"size_before_bytes,omitempty"`
+	// Set for succeeded jobs, unless the size could not be read after the job
+	SizeAfterBytes *float64 `protobuf:"fixed64,13,opt,name=size_after_bytes,json=sizeAfterBytes,proto3,oneof" json:"size_after_bytes,omitempty"`
+	ReclaimedBytes *float64 `protobuf:"fixed64,14,opt,name=reclaimed_bytes,json=reclaimedBytes,proto3,oneof" json:"reclaimed_bytes,omitempty"`
+	Error          string   `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MaintenanceJob) Reset() {
+	*x = MaintenanceJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceJob) ProtoMessage() {}
+
+func (x *MaintenanceJob) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceJob.ProtoReflect.Descriptor instead.
+func (*MaintenanceJob) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MaintenanceJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceJob) GetAction() MaintenanceAction {
+	if x != nil {
+		return x.Action
+	}
+	return MaintenanceAction_MaintenanceActionUnspecified
+}
+
+func (x *MaintenanceJob) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *MaintenanceJob) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *MaintenanceJob) GetStatus() MaintenanceJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MaintenanceJobStatus_MaintenanceJobStatusUnspecified
+}
+
+func (x *MaintenanceJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceJob) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *MaintenanceJob) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *MaintenanceJob) GetBlocksTotal() float64 {
+	if x != nil {
+		return x.BlocksTotal
+	}
+	return 0
+}
+
+func (x *MaintenanceJob) GetBlocksDone() float64 {
+	if x != nil {
+		return x.BlocksDone
+	}
+	return 0
+}
+
+func (x *MaintenanceJob) GetSizeBeforeBytes() float64 {
+	if x != nil {
+		return x.SizeBeforeBytes
+	}
+	return 0
+}
+
+func (x *MaintenanceJob) GetSizeAfterBytes() float64 {
+	if x != nil && x.SizeAfterBytes != nil {
+		return *x.SizeAfterBytes
+	}
+	return 0
+}
+
+func (x *MaintenanceJob) GetReclaimedBytes() float64 {
+	if x != nil && x.ReclaimedBytes != nil {
+		return *x.ReclaimedBytes
+	}
+	return 0
+}
+
+func (x *MaintenanceJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StartMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    MaintenanceAction                   `protobuf:"varint,1,opt,name=action,proto3,enum=collector.MaintenanceAction" json:"action,omitempty"`
+	Relations []*StartMaintenanceRequest_Relation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+}
+
+func (x *StartMaintenanceRequest) Reset() {
+	*x = StartMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMaintenanceRequest) ProtoMessage() {}
+
+func (x *StartMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*StartMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StartMaintenanceRequest) GetAction() MaintenanceAction {
+	if x != nil {
+		return x.Action
+	}
+	return MaintenanceAction_MaintenanceActionUnspecified
+}
+
+func (x *StartMaintenanceRequest) GetRelations() []*StartMaintenanceRequest_Relation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type StartMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*MaintenanceJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *StartMaintenanceResponse) Reset() {
+	*x = StartMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMaintenanceResponse) ProtoMessage() {}
+
+func (x *StartMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*StartMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StartMaintenanceResponse) GetJobs() []*MaintenanceJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetMaintenanceJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetMaintenanceJobRequest) Reset() {
+	*x = GetMaintenanceJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceJobRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceJobRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceJobRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetMaintenanceJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMaintenanceJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *MaintenanceJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetMaintenanceJobResponse) Reset() {
+	*x = GetMaintenanceJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceJobResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceJobResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceJobResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetMaintenanceJobResponse) GetJob() *MaintenanceJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListMaintenanceJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMaintenanceJobsRequest) Reset() {
+	*x = ListMaintenanceJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceJobsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceJobsRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{43}
+}
+
+type ListMaintenanceJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the order jobs were started
+	Jobs []*MaintenanceJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListMaintenanceJobsResponse) Reset() {
+	*x = ListMaintenanceJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceJobsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceJobsResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMaintenanceJobsResponse) GetJobs() []*MaintenanceJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type CancelMaintenanceJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelMaintenanceJobRequest) Reset() {
+	*x = CancelMaintenanceJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceJobRequest) ProtoMessage() {}
+
+func (x *CancelMaintenanceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceJobRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CancelMaintenanceJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelMaintenanceJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *MaintenanceJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *CancelMaintenanceJobResponse) Reset() {
+	*x = CancelMaintenanceJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceJobResponse) ProtoMessage() {}
+
+func (x *CancelMaintenanceJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceJobResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CancelMaintenanceJobResponse) GetJob() *MaintenanceJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListMetricSamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Not set means since the oldest kept sample
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Not set means up to now
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Returns only metrics with these names when set
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *ListMetricSamplesRequest) Reset() {
+	*x = ListMetricSamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetricSamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricSamplesRequest) ProtoMessage() {}
+
+func (x *ListMetricSamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricSamplesRequest.ProtoReflect.Descriptor instead.
+func (*ListMetricSamplesRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListMetricSamplesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListMetricSamplesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListMetricSamplesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ListMetricSamplesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered by taken_at
+	Samples []*ListMetricSamplesResponse_Sample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *ListMetricSamplesResponse) Reset() {
+	*x = ListMetricSamplesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetricSamplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricSamplesResponse) ProtoMessage() {}
+
+func (x *ListMetricSamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricSamplesResponse.ProtoReflect.Descriptor instead.
+func (*ListMetricSamplesResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMetricSamplesResponse) GetSamples() []*ListMetricSamplesResponse_Sample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type ListKnobSamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Not set means since the oldest kept sample
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Not set means up to now
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ListKnobSamplesRequest) Reset() {
+	*x = ListKnobSamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKnobSamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnobSamplesRequest) ProtoMessage() {}
+
+func (x *ListKnobSamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnobSamplesRequest.ProtoReflect.Descriptor instead.
+func (*ListKnobSamplesRequest) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListKnobSamplesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListKnobSamplesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListKnobSamplesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered by taken_at
+	Samples []*ListKnobSamplesResponse_Sample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *ListKnobSamplesResponse) Reset() {
+	*x = ListKnobSamplesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKnobSamplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnobSamplesResponse) ProtoMessage() {}
+
+func (x *ListKnobSamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnobSamplesResponse.ProtoReflect.Descriptor instead.
+func (*ListKnobSamplesResponse) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListKnobSamplesResponse) GetSamples() []*ListKnobSamplesResponse_Sample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type CollectKnobsResponse_Knob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MinValue float64 `protobuf:"fixed64,2,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue float64 `protobuf:"fixed64,3,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*CollectKnobsResponse_Knob_StrValue
+	//	*CollectKnobsResponse_Knob_FloatValue
+	//	*CollectKnobsResponse_Knob_BoolValue
+	Value isCollectKnobsResponse_Knob_Value `protobuf_oneof:"value"`
+	// pg_settings.vartype: bool, integer, real, string or enum
+	Vartype string `protobuf:"bytes,7,opt,name=vartype,proto3" json:"vartype,omitempty"`
+	// Allowed values of enum knobs (pg_settings.enumvals)
+	EnumValues []string `protobuf:"bytes,8,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	// Unit of value and bounds: "B" for memory, "ms" for time, empty for unitless knobs
+	Unit string `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`
+	// pg_settings.context, knobs with "postmaster" context take effect only after restart
+	Context string `protobuf:"bytes,10,opt,name=context,proto3" json:"context,omitempty"`
+	// Value was changed in the configuration but is waiting for a server restart
+	PendingRestart bool `protobuf:"varint,11,opt,name=pending_restart,json=pendingRestart,proto3" json:"pending_restart,omitempty"`
+	// pg_settings.source, e.g. "default", "configuration file" or "command line"
+	Source string `protobuf:"bytes,12,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *CollectKnobsResponse_Knob) Reset() {
+	*x = CollectKnobsResponse_Knob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectKnobsResponse_Knob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectKnobsResponse_Knob) ProtoMessage() {}
+
+func (x *CollectKnobsResponse_Knob) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectKnobsResponse_Knob.ProtoReflect.Descriptor instead.
+func (*CollectKnobsResponse_Knob) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *CollectKnobsResponse_Knob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectKnobsResponse_Knob) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *CollectKnobsResponse_Knob) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+func (m *CollectKnobsResponse_Knob) GetValue() isCollectKnobsResponse_Knob_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *CollectKnobsResponse_Knob) GetStrValue() string {
+	if x, ok := x.GetValue().(*CollectKnobsResponse_Knob_StrValue); ok {
+		return x.StrValue
+	}
+	return ""
+}
+
+func (x *CollectKnobsResponse_Knob) GetFloatValue() float64 {
+	if x, ok := x.GetValue().(*CollectKnobsResponse_Knob_FloatValue); ok {
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *CollectKnobsResponse_Knob) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*CollectKnobsResponse_Knob_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *CollectKnobsResponse_Knob) GetVartype() string {
+	if x != nil {
+		return x.Vartype
+	}
+	return ""
+}
+
+func (x *CollectKnobsResponse_Knob) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *CollectKnobsResponse_Knob) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CollectKnobsResponse_Knob) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *CollectKnobsResponse_Knob) GetPendingRestart() bool {
+	if x != nil {
+		return x.PendingRestart
+	}
+	return false
+}
+
+func (x *CollectKnobsResponse_Knob) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type isCollectKnobsResponse_Knob_Value interface {
+	isCollectKnobsResponse_Knob_Value()
+}
+
+type CollectKnobsResponse_Knob_StrValue struct {
+	StrValue string `protobuf:"bytes,4,opt,name=str_value,json=strValue,proto3,oneof"`
+}
+
+type CollectKnobsResponse_Knob_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,5,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type CollectKnobsResponse_Knob_BoolValue struct {
+	BoolValue bool `protobuf:"varint,6,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*CollectKnobsResponse_Knob_StrValue) isCollectKnobsResponse_Knob_Value() {}
+
+func (*CollectKnobsResponse_Knob_FloatValue) isCollectKnobsResponse_Knob_Value() {}
+
+func (*CollectKnobsResponse_Knob_BoolValue) isCollectKnobsResponse_Knob_Value() {}
+
+type CollectInternalMetricsResponse_Metric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*CollectInternalMetricsResponse_Metric_StrValue
+	//	*CollectInternalMetricsResponse_Metric_FloatValue
+	//	*CollectInternalMetricsResponse_Metric_BoolValue
+	Value isCollectInternalMetricsResponse_Metric_Value `protobuf_oneof:"value"`
+	Scope string                                        `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	// Metric is a cumulative counter, in delta and rate modes its value is converted
+	Counter bool `protobuf:"varint,6,opt,name=counter,proto3" json:"counter,omitempty"`
+	// Counter went backwards since the previous sample, e.g. after pg_stat_reset()
+	CounterReset bool `protobuf:"varint,7,opt,name=counter_reset,json=counterReset,proto3" json:"counter_reset,omitempty"`
+}
+
+func (x *CollectInternalMetricsResponse_Metric) Reset() {
+	*x = CollectInternalMetricsResponse_Metric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectInternalMetricsResponse_Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectInternalMetricsResponse_Metric) ProtoMessage() {}
+
+func (x *CollectInternalMetricsResponse_Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectInternalMetricsResponse_Metric.ProtoReflect.Descriptor instead.
+func (*CollectInternalMetricsResponse_Metric) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *CollectInternalMetricsResponse_Metric) GetValue() isCollectInternalMetricsResponse_Metric_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetStrValue() string {
+	if x, ok := x.GetValue().(*CollectInternalMetricsResponse_Metric_StrValue); ok {
+		return x.StrValue
+	}
+	return ""
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetFloatValue() float32 {
+	if x, ok := x.GetValue().(*CollectInternalMetricsResponse_Metric_FloatValue); ok {
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*CollectInternalMetricsResponse_Metric_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetCounter() bool {
+	if x != nil {
+		return x.Counter
+	}
+	return false
+}
+
+func (x *CollectInternalMetricsResponse_Metric) GetCounterReset() bool {
+	if x != nil {
+		return x.CounterReset
+	}
+	return false
+}
+
+type isCollectInternalMetricsResponse_Metric_Value interface {
+	isCollectInternalMetricsResponse_Metric_Value()
+}
+
+type CollectInternalMetricsResponse_Metric_StrValue struct {
+	StrValue string `protobuf:"bytes,2,opt,name=str_value,json=strValue,proto3,oneof"`
+}
+
+type CollectInternalMetricsResponse_Metric_FloatValue struct {
+	FloatValue float32 `protobuf:"fixed32,3,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type CollectInternalMetricsResponse_Metric_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*CollectInternalMetricsResponse_Metric_StrValue) isCollectInternalMetricsResponse_Metric_Value() {
+}
+
+func (*CollectInternalMetricsResponse_Metric_FloatValue) isCollectInternalMetricsResponse_Metric_Value() {
+}
+
+func (*CollectInternalMetricsResponse_Metric_BoolValue) isCollectInternalMetricsResponse_Metric_Value() {
+}
+
+type CollectExternalMetricsRequest_Script struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Built-in script name or path of a script file on the collector host
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Relative frequency of the script, 1 when not set
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *CollectExternalMetricsRequest_Script) Reset() {
+	*x = CollectExternalMetricsRequest_Script{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectExternalMetricsRequest_Script) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectExternalMetricsRequest_Script) ProtoMessage() {}
+
+func (x *CollectExternalMetricsRequest_Script) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectExternalMetricsRequest_Script.ProtoReflect.Descriptor instead.
+func (*CollectExternalMetricsRequest_Script) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *CollectExternalMetricsRequest_Script) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectExternalMetricsRequest_Script) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type SetKnobsRequest_Knob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*SetKnobsRequest_Knob_FloatValue
+	//	*SetKnobsRequest_Knob_StrValue
+	//	*SetKnobsRequest_Knob_BoolValue
+	Value isSetKnobsRequest_Knob_Value `protobuf_oneof:"value"`
+}
+
+func (x *SetKnobsRequest_Knob) Reset() {
+	*x = SetKnobsRequest_Knob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetKnobsRequest_Knob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKnobsRequest_Knob) ProtoMessage() {}
+
+func (x *SetKnobsRequest_Knob) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKnobsRequest_Knob.ProtoReflect.Descriptor instead.
+func (*SetKnobsRequest_Knob) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *SetKnobsRequest_Knob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *SetKnobsRequest_Knob) GetValue() isSetKnobsRequest_Knob_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *SetKnobsRequest_Knob) GetFloatValue() float64 {
+	if x, ok := x.GetValue().(*SetKnobsRequest_Knob_FloatValue); ok {
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *SetKnobsRequest_Knob) GetStrValue() string {
+	if x, ok := x.GetValue().(*SetKnobsRequest_Knob_StrValue); ok {
+		return x.StrValue
+	}
+	return ""
+}
+
+func (x *SetKnobsRequest_Knob) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*SetKnobsRequest_Knob_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+type isSetKnobsRequest_Knob_Value interface {
+	isSetKnobsRequest_Knob_Value()
+}
+
+type SetKnobsRequest_Knob_FloatValue struct {
+	// Numeric value in the unit reported by CollectKnobs
+	FloatValue float64 `protobuf:"fixed64,2,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type SetKnobsRequest_Knob_StrValue struct {
+	// Enum or string value, or a numeric value with unit such as "256MB" or "5min",
+	// numbers without unit are in the unit reported by CollectKnobs like float_value
+	StrValue string `protobuf:"bytes,3,opt,name=str_value,json=strValue,proto3,oneof"`
+}
+
+type SetKnobsRequest_Knob_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*SetKnobsRequest_Knob_FloatValue) isSetKnobsRequest_Knob_Value() {}
+
+func (*SetKnobsRequest_Knob_StrValue) isSetKnobsRequest_Knob_Value() {}
+
+func (*SetKnobsRequest_Knob_BoolValue) isSetKnobsRequest_Knob_Value() {}
+
+type SetKnobsResponse_Knob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Values are rendered in the units reported by CollectKnobs
+	RequestedValue string          `protobuf:"bytes,2,opt,name=requested_value,json=requestedValue,proto3" json:"requested_value,omitempty"`
+	EffectiveValue string          `protobuf:"bytes,3,opt,name=effective_value,json=effectiveValue,proto3" json:"effective_value,omitempty"`
+	Status         KnobApplyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=collector.KnobApplyStatus" json:"status,omitempty"`
+	Error          string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SetKnobsResponse_Knob) Reset() {
+	*x = SetKnobsResponse_Knob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetKnobsResponse_Knob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKnobsResponse_Knob) ProtoMessage() {}
+
+func (x *SetKnobsResponse_Knob) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKnobsResponse_Knob.ProtoReflect.Descriptor instead.
+func (*SetKnobsResponse_Knob) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *SetKnobsResponse_Knob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetKnobsResponse_Knob) GetRequestedValue() string {
+	if x != nil {
+		return x.RequestedValue
+	}
+	return ""
+}
+
+func (x *SetKnobsResponse_Knob) GetEffectiveValue() string {
+	if x != nil {
+		return x.EffectiveValue
+	}
+	return ""
+}
+
+func (x *SetKnobsResponse_Knob) GetStatus() KnobApplyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KnobApplyStatus_Unspecified
+}
+
+func (x *SetKnobsResponse_Knob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Times are in milliseconds
+type CollectTopStatementsResponse_Statement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId           int64   `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Query             string  `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Calls             float64 `protobuf:"fixed64,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	TotalExecTime     float64 `protobuf:"fixed64,4,opt,name=total_exec_time,json=totalExecTime,proto3" json:"total_exec_time,omitempty"`
+	MeanExecTime      float64 `protobuf:"fixed64,5,opt,name=mean_exec_time,json=meanExecTime,proto3" json:"mean_exec_time,omitempty"`
+	StddevExecTime    float64 `protobuf:"fixed64,6,opt,name=stddev_exec_time,json=stddevExecTime,proto3" json:"stddev_exec_time,omitempty"`
+	Rows              float64 `protobuf:"fixed64,7,opt,name=rows,proto3" json:"rows,omitempty"`
+	SharedBlksHit     float64 `protobuf:"fixed64,8,opt,name=shared_blks_hit,json=sharedBlksHit,proto3" json:"shared_blks_hit,omitempty"`
+	SharedBlksRead    float64 `protobuf:"fixed64,9,opt,name=shared_blks_read,json=sharedBlksRead,proto3" json:"shared_blks_read,omitempty"`
+	SharedBlksDirtied float64 `protobuf:"fixed64,10,opt,name=shared_blks_dirtied,json=sharedBlksDirtied,proto3" json:"shared_blks_dirtied,omitempty"`
+	SharedBlksWritten float64 `protobuf:"fixed64,11,opt,name=shared_blks_written,json=sharedBlksWritten,proto3" json:"shared_blks_written,omitempty"`
+	LocalBlksHit      float64 `protobuf:"fixed64,12,opt,name=local_blks_hit,json=localBlksHit,proto3" json:"local_blks_hit,omitempty"`
+	LocalBlksRead     float64 `protobuf:"fixed64,13,opt,name=local_blks_read,json=localBlksRead,proto3" json:"local_blks_read,omitempty"`
+	LocalBlksDirtied  float64 `protobuf:"fixed64,14,opt,name=local_blks_dirtied,json=localBlksDirtied,proto3" json:"local_blks_dirtied,omitempty"`
+	LocalBlksWritten  float64 `protobuf:"fixed64,15,opt,name=local_blks_written,json=localBlksWritten,proto3" json:"local_blks_written,omitempty"`
+	TempBlksRead      float64 `protobuf:"fixed64,16,opt,name=temp_blks_read,json=tempBlksRead,proto3" json:"temp_blks_read,omitempty"`
+	TempBlksWritten   float64 `protobuf:"fixed64,17,opt,name=temp_blks_written,json=tempBlksWritten,proto3" json:"temp_blks_written,omitempty"`
+	WalBytes          float64 `protobuf:"fixed64,18,opt,name=wal_bytes,json=walBytes,proto3" json:"wal_bytes,omitempty"`
+}
+
+func (x *CollectTopStatementsResponse_Statement) Reset() {
+	*x = CollectTopStatementsResponse_Statement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectTopStatementsResponse_Statement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectTopStatementsResponse_Statement) ProtoMessage() {}
+
+func (x *CollectTopStatementsResponse_Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectTopStatementsResponse_Statement.ProtoReflect.Descriptor instead.
+func (*CollectTopStatementsResponse_Statement) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetQueryId() int64 {
+	if x != nil {
+		return x.QueryId
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetCalls() float64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetTotalExecTime() float64 {
+	if x != nil {
+		return x.TotalExecTime
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetMeanExecTime() float64 {
+	if x != nil {
+		return x.MeanExecTime
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetStddevExecTime() float64 {
+	if x != nil {
+		return x.StddevExecTime
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetRows() float64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetSharedBlksHit() float64 {
+	if x != nil {
+		return x.SharedBlksHit
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetSharedBlksRead() float64 {
+	if x != nil {
+		return x.SharedBlksRead
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetSharedBlksDirtied() float64 {
+	if x != nil {
+		return x.SharedBlksDirtied
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetSharedBlksWritten() float64 {
+	if x != nil {
+		return x.SharedBlksWritten
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetLocalBlksHit() float64 {
+	if x != nil {
+		return x.LocalBlksHit
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetLocalBlksRead() float64 {
+	if x != nil {
+		return x.LocalBlksRead
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetLocalBlksDirtied() float64 {
+	if x != nil {
+		return x.LocalBlksDirtied
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetLocalBlksWritten() float64 {
+	if x != nil {
+		return x.LocalBlksWritten
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetTempBlksRead() float64 {
+	if x != nil {
+		return x.TempBlksRead
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetTempBlksWritten() float64 {
+	if x != nil {
+		return x.TempBlksWritten
+	}
+	return 0
+}
+
+func (x *CollectTopStatementsResponse_Statement) GetWalBytes() float64 {
+	if x != nil {
+		return x.WalBytes
+	}
+	return 0
+}
+
+type CollectWaitEventsResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pg_stat_activity.wait_event_type, "CPU" for active sessions that do not wait
+	WaitEventType string `protobuf:"bytes,1,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
+	WaitEvent     string `protobuf:"bytes,2,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	Count         int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Share of sampled sessions with this wait event
+	Fraction float64 `protobuf:"fixed64,4,opt,name=fraction,proto3" json:"fraction,omitempty"`
+}
+
+func (x *CollectWaitEventsResponse_Event) Reset() {
+	*x = CollectWaitEventsResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectWaitEventsResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectWaitEventsResponse_Event) ProtoMessage() {}
+
+func (x *CollectWaitEventsResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectWaitEventsResponse_Event.ProtoReflect.Descriptor instead.
+func (*CollectWaitEventsResponse_Event) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *CollectWaitEventsResponse_Event) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *CollectWaitEventsResponse_Event) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+func (x *CollectWaitEventsResponse_Event) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CollectWaitEventsResponse_Event) GetFraction() float64 {
+	if x != nil {
+		return x.Fraction
+	}
+	return 0
+}
+
+type CollectReplicationResponse_Replica struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid             int64  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ClientAddr      string `protobuf:"bytes,3,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
+	State           string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// async, potential, sync or quorum
+	SyncState   string  `protobuf:"bytes,5,opt,name=sync_state,json=syncState,proto3" json:"sync_state,omitempty"`
+	WriteLagMs  float64 `protobuf:"fixed64,6,opt,name=write_lag_ms,json=writeLagMs,proto3" json:"write_lag_ms,omitempty"`
+	FlushLagMs  float64 `protobuf:"fixed64,7,opt,name=flush_lag_ms,json=flushLagMs,proto3" json:"flush_lag_ms,omitempty"`
+	ReplayLagMs float64 `protobuf:"fixed64,8,opt,name=replay_lag_ms,json=replayLagMs,proto3" json:"replay_lag_ms,omitempty"`
+	// WAL the replica has not replayed yet
+	ReplayLagBytes float64 `protobuf:"fixed64,9,opt,name=replay_lag_bytes,json=replayLagBytes,proto3" json:"replay_lag_bytes,omitempty"`
+}
+
+func (x *CollectReplicationResponse_Replica) Reset() {
+	*x = CollectReplicationResponse_Replica{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectReplicationResponse_Replica) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectReplicationResponse_Replica) ProtoMessage() {}
+
+func (x *CollectReplicationResponse_Replica) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectReplicationResponse_Replica.ProtoReflect.Descriptor instead.
+func (*CollectReplicationResponse_Replica) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *CollectReplicationResponse_Replica) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *CollectReplicationResponse_Replica) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Replica) GetClientAddr() string {
+	if x != nil {
+		return x.ClientAddr
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Replica) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Replica) GetSyncState() string {
+	if x != nil {
+		return x.SyncState
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Replica) GetWriteLagMs() float64 {
+	if x != nil {
+		return x.WriteLagMs
+	}
+	return 0
+}
+
+func (x *CollectReplicationResponse_Replica) GetFlushLagMs() float64 {
+	if x != nil {
+		return x.FlushLagMs
+	}
+	return 0
+}
+
+func (x *CollectReplicationResponse_Replica) GetReplayLagMs() float64 {
+	if x != nil {
+		return x.ReplayLagMs
+	}
+	return 0
+}
+
+func (x *CollectReplicationResponse_Replica) GetReplayLagBytes() float64 {
+	if x != nil {
+		return x.ReplayLagBytes
+	}
+	return 0
+}
+
+type CollectReplicationResponse_Slot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotName string `protobuf:"bytes,1,opt,name=slot_name,json=slotName,proto3" json:"slot_name,omitempty"`
+	// physical or logical
+	SlotType string `protobuf:"bytes,2,opt,name=slot_type,json=slotType,proto3" json:"slot_type,omitempty"`
+	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Active   bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	// WAL kept on the server for the slot
+	RetainedWalBytes float64 `protobuf:"fixed64,5,opt,name=retained_wal_bytes,json=retainedWalBytes,proto3" json:"retained_wal_bytes,omitempty"`
+	// Empty before PostgreSQL 13
+	WalStatus string `protobuf:"bytes,6,opt,name=wal_status,json=walStatus,proto3" json:"wal_status,omitempty"`
+}
+
+func (x *CollectReplicationResponse_Slot) Reset() {
+	*x = CollectReplicationResponse_Slot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectReplicationResponse_Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectReplicationResponse_Slot) ProtoMessage() {}
+
+func (x *CollectReplicationResponse_Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectReplicationResponse_Slot.ProtoReflect.Descriptor instead.
+func (*CollectReplicationResponse_Slot) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *CollectReplicationResponse_Slot) GetSlotName() string {
+	if x != nil {
+		return x.SlotName
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Slot) GetSlotType() string {
+	if x != nil {
+		return x.SlotType
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Slot) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *CollectReplicationResponse_Slot) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CollectReplicationResponse_Slot) GetRetainedWalBytes() float64 {
+	if x != nil {
+		return x.RetainedWalBytes
+	}
+	return 0
+}
+
+func (x *CollectReplicationResponse_Slot) GetWalStatus() string {
+	if x != nil {
+		return x.WalStatus
+	}
+	return ""
+}
+
+type CollectAutovacuumStateResponse_Settings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// True when both autovacuum and track_counts are on
+	Enabled    bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxWorkers int32 `protobuf:"varint,2,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+	// In kB, -1 means maintenance_work_mem is used
+	WorkMem               int32   `protobuf:"varint,3,opt,name=work_mem,json=workMem,proto3" json:"work_mem,omitempty"`
+	NaptimeSeconds        int32   `protobuf:"varint,4,opt,name=naptime_seconds,json=naptimeSeconds,proto3" json:"naptime_seconds,omitempty"`
+	VacuumThreshold       int32   `protobuf:"varint,5,opt,name=vacuum_threshold,json=vacuumThreshold,proto3" json:"vacuum_threshold,omitempty"`
+	AnalyzeThreshold      int32   `protobuf:"varint,6,opt,name=analyze_threshold,json=analyzeThreshold,proto3" json:"analyze_threshold,omitempty"`
+	VacuumScaleFactor     float64 `protobuf:"fixed64,7,opt,name=vacuum_scale_factor,json=vacuumScaleFactor,proto3" json:"vacuum_scale_factor,omitempty"`
+	AnalyzeScaleFactor    float64 `protobuf:"fixed64,8,opt,name=analyze_scale_factor,json=analyzeScaleFactor,proto3" json:"analyze_scale_factor,omitempty"`
+	FreezeMaxAge          int32   `protobuf:"varint,9,opt,name=freeze_max_age,json=freezeMaxAge,proto3" json:"freeze_max_age,omitempty"`
+	MultixactFreezeMaxAge int32   `protobuf:"varint,10,opt,name=multixact_freeze_max_age,json=multixactFreezeMaxAge,proto3" json:"multixact_freeze_max_age,omitempty"`
+	// In milliseconds, -1 means vacuum_cost_delay is used
+	VacuumCostDelay int32 `protobuf:"varint,11,opt,name=vacuum_cost_delay,json=vacuumCostDelay,proto3" json:"vacuum_cost_delay,omitempty"`
+	// -1 means vacuum_cost_limit is used
+	VacuumCostLimit int32 `protobuf:"varint,12,opt,name=vacuum_cost_limit,json=vacuumCostLimit,proto3" json:"vacuum_cost_limit,omitempty"`
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) Reset() {
+	*x = CollectAutovacuumStateResponse_Settings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAutovacuumStateResponse_Settings) ProtoMessage() {}
+
+func (x *CollectAutovacuumStateResponse_Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAutovacuumStateResponse_Settings.ProtoReflect.Descriptor instead.
+func (*CollectAutovacuumStateResponse_Settings) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetMaxWorkers() int32 {
+	if x != nil {
+		return x.MaxWorkers
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetWorkMem() int32 {
+	if x != nil {
+		return x.WorkMem
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetNaptimeSeconds() int32 {
+	if x != nil {
+		return x.NaptimeSeconds
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetVacuumThreshold() int32 {
+	if x != nil {
+		return x.VacuumThreshold
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetAnalyzeThreshold() int32 {
+	if x != nil {
+		return x.AnalyzeThreshold
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetVacuumScaleFactor() float64 {
+	if x != nil {
+		return x.VacuumScaleFactor
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetAnalyzeScaleFactor() float64 {
+	if x != nil {
+		return x.AnalyzeScaleFactor
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetFreezeMaxAge() int32 {
+	if x != nil {
+		return x.FreezeMaxAge
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetMultixactFreezeMaxAge() int32 {
+	if x != nil {
+		return x.MultixactFreezeMaxAge
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetVacuumCostDelay() int32 {
+	if x != nil {
+		return x.VacuumCostDelay
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Settings) GetVacuumCostLimit() int32 {
+	if x != nil {
+		return x.VacuumCostLimit
+	}
+	return 0
+}
+
+type CollectAutovacuumStateResponse_Relation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaName           string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	RelationName         string `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	LiveRows             int64  `protobuf:"varint,3,opt,name=live_rows,json=liveRows,proto3" json:"live_rows,omitempty"`
+	DeadRows             int64  `protobuf:"varint,4,opt,name=dead_rows,json=deadRows,proto3" json:"dead_rows,omitempty"`
+	ModifiedSinceAnalyze int64  `protobuf:"varint,5,opt,name=modified_since_analyze,json=modifiedSinceAnalyze,proto3" json:"modified_since_analyze,omitempty"`
+	// age(relfrozenxid)
+	XidAge int32 `protobuf:"varint,6,opt,name=xid_age,json=xidAge,proto3" json:"xid_age,omitempty"`
+	// mxid_age(relminmxid)
+	MultixactAge    int32                  `protobuf:"varint,7,opt,name=multixact_age,json=multixactAge,proto3" json:"multixact_age,omitempty"`
+	LastVacuum      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_vacuum,json=lastVacuum,proto3" json:"last_vacuum,omitempty"`
+	LastAutovacuum  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_autovacuum,json=lastAutovacuum,proto3" json:"last_autovacuum,omitempty"`
+	LastAnalyze     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_analyze,json=lastAnalyze,proto3" json:"last_analyze,omitempty"`
+	LastAutoanalyze *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_autoanalyze,json=lastAutoanalyze,proto3" json:"last_autoanalyze,omitempty"`
+	// Settings in effect for the relation, reloptions override database settings
+	Settings   *CollectAutovacuumStateResponse_Settings `protobuf:"bytes,12,opt,name=settings,proto3" json:"settings,omitempty"`
+	Fillfactor int32                                    `protobuf:"varint,13,opt,name=fillfactor,proto3" json:"fillfactor,omitempty"`
+	// Storage parameters set with ALTER TABLE ... SET
+	Reloptions   map[string]string `protobuf:"bytes,14,rep,name=reloptions,proto3" json:"reloptions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NeedsVacuum  bool              `protobuf:"varint,15,opt,name=needs_vacuum,json=needsVacuum,proto3" json:"needs_vacuum,omitempty"`
+	NeedsAnalyze bool              `protobuf:"varint,16,opt,name=needs_analyze,json=needsAnalyze,proto3" json:"needs_analyze,omitempty"`
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) Reset() {
+	*x = CollectAutovacuumStateResponse_Relation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAutovacuumStateResponse_Relation) ProtoMessage() {}
+
+func (x *CollectAutovacuumStateResponse_Relation) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAutovacuumStateResponse_Relation.ProtoReflect.Descriptor instead.
+func (*CollectAutovacuumStateResponse_Relation) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetLiveRows() int64 {
+	if x != nil {
+		return x.LiveRows
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetDeadRows() int64 {
+	if x != nil {
+		return x.DeadRows
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetModifiedSinceAnalyze() int64 {
+	if x != nil {
+		return x.ModifiedSinceAnalyze
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetXidAge() int32 {
+	if x != nil {
+		return x.XidAge
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetMultixactAge() int32 {
+	if x != nil {
+		return x.MultixactAge
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetLastVacuum() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVacuum
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetLastAutovacuum() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAutovacuum
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetLastAnalyze() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAnalyze
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetLastAutoanalyze() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAutoanalyze
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetSettings() *CollectAutovacuumStateResponse_Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetFillfactor() int32 {
+	if x != nil {
+		return x.Fillfactor
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetReloptions() map[string]string {
+	if x != nil {
+		return x.Reloptions
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetNeedsVacuum() bool {
+	if x != nil {
+		return x.NeedsVacuum
+	}
+	return false
+}
+
+func (x *CollectAutovacuumStateResponse_Relation) GetNeedsAnalyze() bool {
+	if x != nil {
+		return x.NeedsAnalyze
+	}
+	return false
+}
+
+type CollectAutovacuumStateResponse_Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid              int64                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	User             string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	State            string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Query            string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	TransactionStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_start,json=transactionStart,proto3" json:"transaction_start,omitempty"`
+	DurationMs       float64                `protobuf:"fixed64,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	BackendXminAge   int64                  `protobuf:"varint,7,opt,name=backend_xmin_age,json=backendXminAge,proto3" json:"backend_xmin_age,omitempty"`
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) Reset() {
+	*x = CollectAutovacuumStateResponse_Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAutovacuumStateResponse_Transaction) ProtoMessage() {}
+
+func (x *CollectAutovacuumStateResponse_Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAutovacuumStateResponse_Transaction.ProtoReflect.Descriptor instead.
+func (*CollectAutovacuumStateResponse_Transaction) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{31, 2}
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetTransactionStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionStart
+	}
+	return nil
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *CollectAutovacuumStateResponse_Transaction) GetBackendXminAge() int64 {
+	if x != nil {
+		return x.BackendXminAge
+	}
+	return 0
+}
+
+type RecommendTableOptionsResponse_Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Value in effect, inherited from database settings when the table does not override it
+	CurrentValue string `protobuf:"bytes,3,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	Rationale    string `protobuf:"bytes,4,opt,name=rationale,proto3" json:"rationale,omitempty"`
+}
+
+func (x *RecommendTableOptionsResponse_Option) Reset() {
+	*x = RecommendTableOptionsResponse_Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendTableOptionsResponse_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendTableOptionsResponse_Option) ProtoMessage() {}
+
+func (x *RecommendTableOptionsResponse_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendTableOptionsResponse_Option.ProtoReflect.Descriptor instead.
+func (*RecommendTableOptionsResponse_Option) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *RecommendTableOptionsResponse_Option) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecommendTableOptionsResponse_Option) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RecommendTableOptionsResponse_Option) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+func (x *RecommendTableOptionsResponse_Option) GetRationale() string {
+	if x != nil {
+		return x.Rationale
+	}
+	return ""
+}
+
+type RecommendTableOptionsResponse_Table struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaName   string                                  `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	RelationName string                                  `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	Options      []*RecommendTableOptionsResponse_Option `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *RecommendTableOptionsResponse_Table) Reset() {
+	*x = RecommendTableOptionsResponse_Table{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendTableOptionsResponse_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendTableOptionsResponse_Table) ProtoMessage() {}
+
+func (x *RecommendTableOptionsResponse_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendTableOptionsResponse_Table.ProtoReflect.Descriptor instead.
+func (*RecommendTableOptionsResponse_Table) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *RecommendTableOptionsResponse_Table) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *RecommendTableOptionsResponse_Table) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *RecommendTableOptionsResponse_Table) GetOptions() []*RecommendTableOptionsResponse_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SetTableOptionsRequest_Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Storage parameter, e.g. fillfactor or autovacuum_vacuum_scale_factor
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetTableOptionsRequest_Option) Reset() {
+	*x = SetTableOptionsRequest_Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTableOptionsRequest_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTableOptionsRequest_Option) ProtoMessage() {}
+
+func (x *SetTableOptionsRequest_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTableOptionsRequest_Option.ProtoReflect.Descriptor instead.
+func (*SetTableOptionsRequest_Option) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *SetTableOptionsRequest_Option) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetTableOptionsRequest_Option) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetTableOptionsRequest_Table struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaName   string                           `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	RelationName string                           `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	Options      []*SetTableOptionsRequest_Option `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *SetTableOptionsRequest_Table) Reset() {
+	*x = SetTableOptionsRequest_Table{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTableOptionsRequest_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTableOptionsRequest_Table) ProtoMessage() {}
+
+func (x *SetTableOptionsRequest_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTableOptionsRequest_Table.ProtoReflect.Descriptor instead.
+func (*SetTableOptionsRequest_Table) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *SetTableOptionsRequest_Table) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *SetTableOptionsRequest_Table) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *SetTableOptionsRequest_Table) GetOptions() []*SetTableOptionsRequest_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SetTableOptionsResponse_Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaName     string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	RelationName   string `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RequestedValue string `protobuf:"bytes,4,opt,name=requested_value,json=requestedValue,proto3" json:"requested_value,omitempty"`
+	// Value read back from pg_class.reloptions
+	EffectiveValue string          `protobuf:"bytes,5,opt,name=effective_value,json=effectiveValue,proto3" json:"effective_value,omitempty"`
+	Status         KnobApplyStatus `protobuf:"varint,6,opt,name=status,proto3,enum=collector.KnobApplyStatus" json:"status,omitempty"`
+	Error          string          `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SetTableOptionsResponse_Option) Reset() {
+	*x = SetTableOptionsResponse_Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTableOptionsResponse_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTableOptionsResponse_Option) ProtoMessage() {}
+
+func (x *SetTableOptionsResponse_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTableOptionsResponse_Option.ProtoReflect.Descriptor instead.
+func (*SetTableOptionsResponse_Option) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *SetTableOptionsResponse_Option) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *SetTableOptionsResponse_Option) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *SetTableOptionsResponse_Option) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetTableOptionsResponse_Option) GetRequestedValue() string {
+	if x != nil {
+		return x.RequestedValue
+	}
+	return ""
+}
+
+func (x *SetTableOptionsResponse_Option) GetEffectiveValue() string {
+	if x != nil {
+		return x.EffectiveValue
+	}
+	return ""
+}
+
+func (x *SetTableOptionsResponse_Option) GetStatus() KnobApplyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KnobApplyStatus_Unspecified
+}
+
+func (x *SetTableOptionsResponse_Option) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecommendIndexesResponse_Recommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       IndexRecommendationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=collector.IndexRecommendationKind" json:"kind,omitempty"`
+	SchemaName string                  `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName  string                  `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IndexName  string                  `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// Index that covers a duplicate or overlapping one
+	RelatedIndex string `protobuf:"bytes,5,opt,name=related_index,json=relatedIndex,proto3" json:"related_index,omitempty"`
+	Reason       string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Estimated space freed
+	SavingsBytes float64 `protobuf:"fixed64,7,opt,name=savings_bytes,json=savingsBytes,proto3" json:"savings_bytes,omitempty"`
+	// DROP INDEX CONCURRENTLY or REINDEX INDEX CONCURRENTLY statement
+	Statement string `protobuf:"bytes,8,opt,name=statement,proto3" json:"statement,omitempty"`
+}
+
+func (x *RecommendIndexesResponse_Recommendation) Reset() {
+	*x = RecommendIndexesResponse_Recommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendIndexesResponse_Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendIndexesResponse_Recommendation) ProtoMessage() {}
+
+func (x *RecommendIndexesResponse_Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendIndexesResponse_Recommendation.ProtoReflect.Descriptor instead.
+func (*RecommendIndexesResponse_Recommendation) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetKind() IndexRecommendationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IndexRecommendationKind_IndexRecommendationKindUnspecified
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetRelatedIndex() string {
+	if x != nil {
+		return x.RelatedIndex
+	}
+	return ""
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetSavingsBytes() float64 {
+	if x != nil {
+		return x.SavingsBytes
+	}
+	return 0
+}
+
+func (x *RecommendIndexesResponse_Recommendation) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+type StartMaintenanceRequest_Relation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaName string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// Table, or index for ReindexConcurrently
+	RelationName string `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+}
+
+func (x *StartMaintenanceRequest_Relation) Reset() {
+	*x = StartMaintenanceRequest_Relation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMaintenanceRequest_Relation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMaintenanceRequest_Relation) ProtoMessage() {}
+
+func (x *StartMaintenanceRequest_Relation) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMaintenanceRequest_Relation.ProtoReflect.Descriptor instead.
+func (*StartMaintenanceRequest_Relation) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *StartMaintenanceRequest_Relation) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *StartMaintenanceRequest_Relation) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+type ListMetricSamplesResponse_Sample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TakenAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	// Counters hold raw cumulative values
+	Metrics []*CollectInternalMetricsResponse_Metric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *ListMetricSamplesResponse_Sample) Reset() {
+	*x = ListMetricSamplesResponse_Sample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetricSamplesResponse_Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricSamplesResponse_Sample) ProtoMessage() {}
+
+func (x *ListMetricSamplesResponse_Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricSamplesResponse_Sample.ProtoReflect.Descriptor instead.
+func (*ListMetricSamplesResponse_Sample) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *ListMetricSamplesResponse_Sample) GetTakenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TakenAt
+	}
+	return nil
+}
+
+func (x *ListMetricSamplesResponse_Sample) GetMetrics() []*CollectInternalMetricsResponse_Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type ListKnobSamplesResponse_Sample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TakenAt *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	Knobs   []*CollectKnobsResponse_Knob `protobuf:"bytes,2,rep,name=knobs,proto3" json:"knobs,omitempty"`
+}
+
+func (x *ListKnobSamplesResponse_Sample) Reset() {
+	*x = ListKnobSamplesResponse_Sample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collector_collector_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKnobSamplesResponse_Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnobSamplesResponse_Sample) ProtoMessage() {}
+
+func (x *ListKnobSamplesResponse_Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_collector_collector_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnobSamplesResponse_Sample.ProtoReflect.Descriptor instead.
+func (*ListKnobSamplesResponse_Sample) Descriptor() ([]byte, []int) {
+	return file_collector_collector_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *ListKnobSamplesResponse_Sample) GetTakenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TakenAt
+	}
+	return nil
+}
+
+func (x *ListKnobSamplesResponse_Sample) GetKnobs() []*CollectKnobsResponse_Knob {
+	if x != nil {
+		return x.Knobs
+	}
+	return nil
+}
+
+var File_collector_collector_proto protoreflect.FileDescriptor
+
+var file_collector_collector_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbf,
+	0x03, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52, 0x05, 0x6b, 0x6e,
+	0x6f, 0x62, 0x73, 0x1a, 0xea, 0x02, 0x0a, 0x04, 0x4b, 0x6e, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74,
+	0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a,
+	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x61, 0x72, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x61, 0x72, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x8f, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0xf7, 0x02, 0x0a, 0x1e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0xdd, 0x01, 0x0a,
+	0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x73,
+	0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x01, 0x0a,
+	0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x4a, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xb5, 0x03, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x55, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x34, 0x0a, 0x06, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xeb, 0x01, 0x0a,
+	0x1e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x74, 0x70,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x35, 0x30, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x35, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x35, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x39, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x39, 0x12, 0x22,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x49, 0x6e,
+	0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a,
+	0x10, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x1a, 0x86, 0x01, 0x0a,
+	0x04, 0x4b, 0x6e, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09,
+	0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x6b, 0x6e,
+	0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52, 0x05, 0x6b, 0x6e, 0x6f,
+	0x62, 0x73, 0x1a, 0xb6, 0x01, 0x0a, 0x04, 0x4b, 0x6e, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x6e,
+	0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x0c,
+	0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x6b,
+	0x6e, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x62,
+	0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4b,
+	0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a,
+	0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05,
+	0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52, 0x05, 0x6b,
+	0x6e, 0x6f, 0x62, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4b, 0x6e, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x12, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x6c, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x9d, 0x06, 0x0a, 0x1c, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xa9, 0x05, 0x0a, 0x09, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45,
+	0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f,
+	0x65, 0x78, 0x65, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x45,
+	0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73,
+	0x48, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c,
+	0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x64, 0x69, 0x72,
+	0x74, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x44, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x24, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73,
+	0x48, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b,
+	0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x74, 0x69, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c,
+	0x6b, 0x73, 0x44, 0x69, 0x72, 0x74, 0x69, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6b, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x5f,
+	0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x74, 0x65, 0x6d, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x62, 0x6c, 0x6b, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x42, 0x6c,
+	0x6b, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x61,
+	0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73,
+	0x22, 0x41, 0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x57, 0x61, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbf, 0x05, 0x0a, 0x1a, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x1a, 0xae, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67,
+	0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x4c, 0x61, 0x67, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x6c,
+	0x61, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x75,
+	0x73, 0x68, 0x4c, 0x61, 0x67, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6c, 0x61, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4c, 0x61, 0x67, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6c, 0x61, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4c, 0x61, 0x67,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0xc1, 0x01, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6c, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x6c, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x64, 0x57, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61,
+	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x77, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x6c, 0x6f,
+	0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6c, 0x6f,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xb1, 0x0f, 0x0a, 0x1e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x50, 0x0a, 0x09,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x71,
+	0x0a, 0x19, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x17, 0x6c, 0x6f, 0x6e, 0x67, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0xfa, 0x03, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x6d, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x6f, 0x72,
+	0x6b, 0x4d, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x61, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e,
+	0x61, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x37, 0x0a,
+	0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x15, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x76,
+	0x61, 0x63, 0x75, 0x75, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0xe1,
+	0x06, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x78, 0x69, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x78, 0x69, 0x64, 0x41, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x78, 0x61, 0x63, 0x74, 0x41, 0x67, 0x65, 0x12,
+	0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x43, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75,
+	0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x12, 0x45, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x6c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x6c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x72, 0x65, 0x6c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x65, 0x64, 0x73, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x23,
+	0x0a, 0x0d, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0xf3, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x78, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x58, 0x6d, 0x69, 0x6e, 0x41, 0x67, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf9, 0x02, 0x0a, 0x1d, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x1a, 0x75, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x65, 0x1a, 0x98, 0x01, 0x0a, 0x05, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x1a, 0x32, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x91, 0x01, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdf, 0x02, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xfe, 0x01, 0x0a, 0x06, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x6e, 0x6f,
+	0x62, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdf, 0x03, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12,
+	0x5c, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa7, 0x02,
+	0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x76,
+	0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x9a, 0x05, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x50, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x2a,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x03, 0x6a, 0x6f, 0x62, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x22, 0x2d, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4b, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x8c, 0x01, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x1a, 0x8b, 0x01, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x74,
+	0x61, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x61, 0x6b, 0x65, 0x6e,
+	0x41, 0x74, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x74,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x74, 0x6f, 0x22, 0xdb, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f,
+	0x62, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x1a, 0x7b, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74,
+	0x61, 0x6b, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x62, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x62, 0x52, 0x05, 0x6b, 0x6e, 0x6f,
+	0x62, 0x73, 0x2a, 0x47, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4d, 0x6f, 0x64, 0x65,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x52, 0x61, 0x77, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x10, 0x03, 0x2a, 0x7a, 0x0a, 0x0b, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x10, 0x02, 0x12,
+	0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03,
+	0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x61, 0x74, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x10, 0x06, 0x2a, 0x51, 0x0a, 0x0f, 0x4b, 0x6e, 0x6f, 0x62, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x03, 0x2a, 0xa6, 0x01, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x54,
+	0x69, 0x6d, 0x65, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x10, 0x02,
+	0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x61, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x54, 0x69, 0x6d, 0x65,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x10, 0x05,
+	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x42, 0x6c, 0x6b, 0x73, 0x57, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x10, 0x07, 0x2a, 0xa0, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x26, 0x0a, 0x22, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x10, 0x05, 0x2a, 0x72, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x10,
+	0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x14, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x32, 0x90, 0x12,
+	0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b,
+	0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4b,
+	0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x15, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x16, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x49, 0x6e, 0x69, 0x74,
+	0x4c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x69,
+	0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x08, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x62,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f,
+	0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x65, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x57, 0x61,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x10, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x25,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e,
+	0x6f, 0x62, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x62, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f,
+	0x62, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x08, 0x5a, 0x06, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_collector_collector_proto_rawDescOnce sync.Once
+	file_collector_collector_proto_rawDescData = file_collector_collector_proto_rawDesc
+)
+
+func file_collector_collector_proto_rawDescGZIP() []byte {
+	file_collector_collector_proto_rawDescOnce.Do(func() {
+		file_collector_collector_proto_rawDescData = protoimpl.X.CompressGZIP(file_collector_collector_proto_rawDescData)
+	})
+	return file_collector_collector_proto_rawDescData
+}
+
+var file_collector_collector_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_collector_collector_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_collector_collector_proto_goTypes = []interface{}{
+	(MetricsMode)(0),                              // 0: collector.MetricsMode
+	(MetricGroup)(0),                              // 1: collector.MetricGroup
+	(KnobApplyStatus)(0),                          // 2: collector.KnobApplyStatus
+	(StatementsOrderBy)(0),                        // 3: collector.StatementsOrderBy
+	(IndexRecommendationKind)(0),                  // 4: collector.IndexRecommendationKind
+	(MaintenanceAction)(0),                        // 5: collector.MaintenanceAction
+	(MaintenanceJobStatus)(0),                     // 6: collector.MaintenanceJobStatus
+	(*CollectKnobsRequest)(nil),                   // 7: collector.CollectKnobsRequest
+	(*CollectKnobsResponse)(nil),                  // 8: collector.CollectKnobsResponse
+	(*CollectInternalMetricsRequest)(nil),         // 9: collector.CollectInternalMetricsRequest
+	(*CollectInternalMetricsResponse)(nil),        // 10: collector.CollectInternalMetricsResponse
+	(*StreamInternalMetricsRequest)(nil),          // 11: collector.StreamInternalMetricsRequest
+	(*StreamInternalMetricsResponse)(nil),         // 12: collector.StreamInternalMetricsResponse
+	(*CollectExternalMetricsRequest)(nil),         // 13: collector.CollectExternalMetricsRequest
+	(*CollectExternalMetricsResponse)(nil),        // 14: collector.CollectExternalMetricsResponse
+	(*InitLoadRequest)(nil),                       // 15: collector.InitLoadRequest
+	(*InitLoadResponse)(nil),                      // 16: collector.InitLoadResponse
+	(*SetKnobsRequest)(nil),                       // 17: collector.SetKnobsRequest
+	(*SetKnobsResponse)(nil),                      // 18: collector.SetKnobsResponse
+	(*KnobSnapshot)(nil),                          // 19: collector.KnobSnapshot
+	(*CreateKnobSnapshotRequest)(nil),             // 20: collector.CreateKnobSnapshotRequest
+	(*CreateKnobSnapshotResponse)(nil),            // 21: collector.CreateKnobSnapshotResponse
+	(*ListKnobSnapshotsRequest)(nil),              // 22: collector.ListKnobSnapshotsRequest
+	(*ListKnobSnapshotsResponse)(nil),             // 23: collector.ListKnobSnapshotsResponse
+	(*RestoreKnobSnapshotRequest)(nil),            // 24: collector.RestoreKnobSnapshotRequest
+	(*RestoreKnobSnapshotResponse)(nil),           // 25: collector.RestoreKnobSnapshotResponse
+	(*ResetKnobsRequest)(nil),                     // 26: collector.ResetKnobsRequest
+	(*ResetKnobsResponse)(nil),                    // 27: collector.ResetKnobsResponse
+	(*CollectTopStatementsRequest)(nil),           // 28: collector.CollectTopStatementsRequest
+	(*CollectTopStatementsResponse)(nil),          // 29: collector.CollectTopStatementsResponse
+	(*BlockingNode)(nil),                          // 30: collector.BlockingNode
+	(*CollectBlockingTreeRequest)(nil),            // 31: collector.CollectBlockingTreeRequest
+	(*CollectBlockingTreeResponse)(nil),           // 32: collector.CollectBlockingTreeResponse
+	(*CollectWaitEventsRequest)(nil),              // 33: collector.CollectWaitEventsRequest
+	(*CollectWaitEventsResponse)(nil),             // 34: collector.CollectWaitEventsResponse
+	(*CollectReplicationRequest)(nil),             // 35: collector.CollectReplicationRequest
+	(*CollectReplicationResponse)(nil),            // 36: collector.CollectReplicationResponse
+	(*CollectAutovacuumStateRequest)(nil),         // 37: collector.CollectAutovacuumStateRequest
+	(*CollectAutovacuumStateResponse)(nil),        // 38: collector.CollectAutovacuumStateResponse
+	(*RecommendTableOptionsRequest)(nil),          // 39: collector.RecommendTableOptionsRequest
+	(*RecommendTableOptionsResponse)(nil),         // 40: collector.RecommendTableOptionsResponse
+	(*SetTableOptionsRequest)(nil),                // 41: collector.SetTableOptionsRequest
+	(*SetTableOptionsResponse)(nil),               // 42: collector.SetTableOptionsResponse
+	(*RecommendIndexesRequest)(nil),               // 43: collector.RecommendIndexesRequest
+	(*RecommendIndexesResponse)(nil),              // 44: collector.RecommendIndexesResponse
+	(*MaintenanceJob)(nil),                        // 45: collector.MaintenanceJob
+	(*StartMaintenanceRequest)(nil),               // 46: collector.StartMaintenanceRequest
+	(*StartMaintenanceResponse)(nil),              // 47: collector.StartMaintenanceResponse
+	(*GetMaintenanceJobRequest)(nil),              // 48: collector.GetMaintenanceJobRequest
+	(*GetMaintenanceJobResponse)(nil),             // 49: collector.GetMaintenanceJobResponse
+	(*ListMaintenanceJobsRequest)(nil),            // 50: collector.ListMaintenanceJobsRequest
+	(*ListMaintenanceJobsResponse)(nil),           // 51: collector.ListMaintenanceJobsResponse
+	(*CancelMaintenanceJobRequest)(nil),           // 52: collector.CancelMaintenanceJobRequest
+	(*CancelMaintenanceJobResponse)(nil),          // 53: collector.CancelMaintenanceJobResponse
+	(*ListMetricSamplesRequest)(nil),              // 54: collector.ListMetricSamplesRequest
+	(*ListMetricSamplesResponse)(nil),             // 55: collector.ListMetricSamplesResponse
+	(*ListKnobSamplesRequest)(nil),                // 56: collector.ListKnobSamplesRequest
+	(*ListKnobSamplesResponse)(nil),               // 57: collector.ListKnobSamplesResponse
+	(*CollectKnobsResponse_Knob)(nil),             // 58: collector.CollectKnobsResponse.Knob
+	(*CollectInternalMetricsResponse_Metric)(nil), // 59: collector.CollectInternalMetricsResponse.Metric
+	(*CollectExternalMetricsRequest_Script)(nil),  // 60: collector.CollectExternalMetricsRequest.Script
+	nil,                           // 61: collector.CollectExternalMetricsRequest.VariablesEntry
+	(*SetKnobsRequest_Knob)(nil),  // 62: collector.SetKnobsRequest.Knob
+	(*SetKnobsResponse_Knob)(nil), // 63: collector.SetKnobsResponse.Knob
+	(*CollectTopStatementsResponse_Statement)(nil),     // 64: collector.CollectTopStatementsResponse.Statement
+	(*CollectWaitEventsResponse_Event)(nil),            // 65: collector.CollectWaitEventsResponse.Event
+	(*CollectReplicationResponse_Replica)(nil),         // 66: collector.CollectReplicationResponse.Replica
+	(*CollectReplicationResponse_Slot)(nil),            // 67: collector.CollectReplicationResponse.Slot
+	(*CollectAutovacuumStateResponse_Settings)(nil),    // 68: collector.CollectAutovacuumStateResponse.Settings
+	(*CollectAutovacuumStateResponse_Relation)(nil),    // 69: collector.CollectAutovacuumStateResponse.Relation
+	(*CollectAutovacuumStateResponse_Transaction)(nil), // 70: collector.CollectAutovacuumStateResponse.Transaction
+	nil, // 71: collector.CollectAutovacuumStateResponse.Relation.ReloptionsEntry
+	(*RecommendTableOptionsResponse_Option)(nil),    // 72: collector.RecommendTableOptionsResponse.Option
+	(*RecommendTableOptionsResponse_Table)(nil),     // 73: collector.RecommendTableOptionsResponse.Table
+	(*SetTableOptionsRequest_Option)(nil),           // 74: collector.SetTableOptionsRequest.Option
+	(*SetTableOptionsRequest_Table)(nil),            // 75: collector.SetTableOptionsRequest.Table
+	(*SetTableOptionsResponse_Option)(nil),          // 76: collector.SetTableOptionsResponse.Option
+	(*RecommendIndexesResponse_Recommendation)(nil), // 77: collector.RecommendIndexesResponse.Recommendation
+	(*StartMaintenanceRequest_Relation)(nil),        // 78: collector.StartMaintenanceRequest.Relation
+	(*ListMetricSamplesResponse_Sample)(nil),        // 79: collector.ListMetricSamplesResponse.Sample
+	(*ListKnobSamplesResponse_Sample)(nil),          // 80: collector.ListKnobSamplesResponse.Sample
+	(*timestamppb.Timestamp)(nil),                   // 81: google.protobuf.Timestamp
+}
+var file_collector_collector_proto_depIdxs = []int32{
+	58, // 0: collector.CollectKnobsResponse.knobs:type_name -> collector.CollectKnobsResponse.Knob
+	0,  // 1: collector.CollectInternalMetricsRequest.mode:type_name -> collector.MetricsMode
+	59, // 2: collector.CollectInternalMetricsResponse.metrics:type_name -> collector.CollectInternalMetricsResponse.Metric
+	1,  // 3: collector.StreamInternalMetricsRequest.groups:type_name -> collector.MetricGroup
+	0,  // 4: collector.StreamInternalMetricsRequest.mode:type_name -> collector.MetricsMode
+	81, // 5: collector.StreamInternalMetricsResponse.taken_at:type_name -> google.protobuf.Timestamp
+	59, // 6: collector.StreamInternalMetricsResponse.metrics:type_name -> collector.CollectInternalMetricsResponse.Metric
+	60, // 7: collector.CollectExternalMetricsRequest.builtins:type_name -> collector.CollectExternalMetricsRequest.Script
+	60, // 8: collector.CollectExternalMetricsRequest.scripts:type_name -> collector.CollectExternalMetricsRequest.Script
+	61, // 9: collector.CollectExternalMetricsRequest.variables:type_name -> collector.CollectExternalMetricsRequest.VariablesEntry
+	62, // 10: collector.SetKnobsRequest.knobs:type_name -> collector.SetKnobsRequest.Knob
+	63, // 11: collector.SetKnobsResponse.knobs:type_name -> collector.SetKnobsResponse.Knob
+	81, // 12: collector.KnobSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	58, // 13: collector.KnobSnapshot.knobs:type_name -> collector.CollectKnobsResponse.Knob
+	19, // 14: collector.CreateKnobSnapshotResponse.snapshot:type_name -> collector.KnobSnapshot
+	19, // 15: collector.ListKnobSnapshotsResponse.snapshots:type_name -> collector.KnobSnapshot
+	63, // 16: collector.RestoreKnobSnapshotResponse.knobs:type_name -> collector.SetKnobsResponse.Knob
+	3,  // 17: collector.CollectTopStatementsRequest.order_by:type_name -> collector.StatementsOrderBy
+	64, // 18: collector.CollectTopStatementsResponse.statements:type_name -> collector.CollectTopStatementsResponse.Statement
+	30, // 19: collector.BlockingNode.blocked:type_name -> collector.BlockingNode
+	30, // 20: collector.CollectBlockingTreeResponse.roots:type_name -> collector.BlockingNode
+	81, // 21: collector.CollectWaitEventsResponse.from:type_name -> google.protobuf.Timestamp
+	81, // 22: collector.CollectWaitEventsResponse.to:type_name -> google.protobuf.Timestamp
+	65, // 23: collector.CollectWaitEventsResponse.events:type_name -> collector.CollectWaitEventsResponse.Event
+	66, // 24: collector.CollectReplicationResponse.replicas:type_name -> collector.CollectReplicationResponse.Replica
+	67, // 25: collector.CollectReplicationResponse.slots:type_name -> collector.CollectReplicationResponse.Slot
+	68, // 26: collector.CollectAutovacuumStateResponse.settings:type_name -> collector.CollectAutovacuumStateResponse.Settings
+	69, // 27: collector.CollectAutovacuumStateResponse.relations:type_name -> collector.CollectAutovacuumStateResponse.Relation
+	70, // 28: collector.CollectAutovacuumStateResponse.long_running_transactions:type_name -> collector.CollectAutovacuumStateResponse.Transaction
+	73, // 29: collector.RecommendTableOptionsResponse.tables:type_name -> collector.RecommendTableOptionsResponse.Table
+	75, // 30: collector.SetTableOptionsRequest.tables:type_name -> collector.SetTableOptionsRequest.Table
+	76, // 31: collector.SetTableOptionsResponse.options:type_name -> collector.SetTableOptionsResponse.Option
+	81, // 32: collector.RecommendIndexesResponse.stats_reset:type_name -> google.protobuf.Timestamp
+	77, // 33: collector.RecommendIndexesResponse.recommendations:type_name -> collector.RecommendIndexesResponse.Recommendation
+	5,  // 34: collector.MaintenanceJob.action:type_name -> collector.MaintenanceAction
+	6,  // 35: collector.MaintenanceJob.status:type_name -> collector.MaintenanceJobStatus
+	81, // 36: collector.MaintenanceJob.started_at:type_name -> google.protobuf.Timestamp
+	81, // 37: collector.MaintenanceJob.finished_at:type_name -> google.protobuf.Timestamp
+	5,  // 38: collector.StartMaintenanceRequest.action:type_name -> collector.MaintenanceAction
+	78, // 39: collector.StartMaintenanceRequest.relations:type_name -> collector.StartMaintenanceRequest.Relation
+	45, // 40: collector.StartMaintenanceResponse.jobs:type_name -> collector.MaintenanceJob
+	45, // 41: collector.GetMaintenanceJobResponse.job:type_name -> collector.MaintenanceJob
+	45, // 42: collector.ListMaintenanceJobsResponse.jobs:type_name -> collector.MaintenanceJob
+	45, // 43: collector.CancelMaintenanceJobResponse.job:type_name -> collector.MaintenanceJob
+	81, // 44: collector.ListMetricSamplesRequest.from:type_name -> google.protobuf.Timestamp
+	81, // 45: collector.ListMetricSamplesRequest.to:type_name -> google.protobuf.Timestamp
+	79, // 46: collector.ListMetricSamplesResponse.samples:type_name -> collector.ListMetricSamplesResponse.Sample
+	81, // 47: collector.ListKnobSamplesRequest.from:type_name -> google.protobuf.Timestamp
+	81, // 48: collector.ListKnobSamplesRequest.to:type_name -> google.protobuf.Timestamp
+	80, // 49: collector.ListKnobSamplesResponse.samples:type_name -> collector.ListKnobSamplesResponse.Sample
+	2,  // 50: collector.SetKnobsResponse.Knob.status:type_name -> collector.KnobApplyStatus
+	81, // 51: collector.CollectAutovacuumStateResponse.Relation.last_vacuum:type_name -> google.protobuf.Timestamp
+	81, // 52: collector.CollectAutovacuumStateResponse.Relation.last_autovacuum:type_name -> google.protobuf.Timestamp
+	81, // 53: collector.CollectAutovacuumStateResponse.Relation.last_analyze:type_name -> google.protobuf.Timestamp
+	81, // 54: collector.CollectAutovacuumStateResponse.Relation.last_autoanalyze:type_name -> google.protobuf.Timestamp
+	68, // 55: collector.CollectAutovacuumStateResponse.Relation.settings:type_name -> collector.CollectAutovacuumStateResponse.Settings
+	71, // 56: collector.CollectAutovacuumStateResponse.Relation.reloptions:type_name -> collector.CollectAutovacuumStateResponse.Relation.ReloptionsEntry
+	81, // 57: collector.CollectAutovacuumStateResponse.Transaction.transaction_start:type_name -> google.protobuf.Timestamp
+	72, // 58: collector.RecommendTableOptionsResponse.Table.options:type_name -> collector.RecommendTableOptionsResponse.Option
+	74, // 59: collector.SetTableOptionsRequest.Table.options:type_name -> collector.SetTableOptionsRequest.Option
+	2,  // 60: collector.SetTableOptionsResponse.Option.status:type_name -> collector.KnobApplyStatus
+	4,  // 61: collector.RecommendIndexesResponse.Recommendation.kind:type_name -> collector.IndexRecommendationKind
+	81, // 62: collector.ListMetricSamplesResponse.Sample.taken_at:type_name -> google.protobuf.Timestamp
+	59, // 63: collector.ListMetricSamplesResponse.Sample.metrics:type_name -> collector.CollectInternalMetricsResponse.Metric
+	81, // 64: collector.ListKnobSamplesResponse.Sample.taken_at:type_name -> google.protobuf.Timestamp
+	58, // 65: collector.ListKnobSamplesResponse.Sample.knobs:type_name -> collector.CollectKnobsResponse.Knob
+	7,  // 66: collector.Collector.CollectKnobs:input_type -> collector.CollectKnobsRequest
+	9,  // 67: collector.Collector.CollectInternalMetrics:input_type -> collector.CollectInternalMetricsRequest
+	11, // 68: collector.Collector.StreamInternalMetrics:input_type -> collector.StreamInternalMetricsRequest
+	13, // 69: collector.Collector.CollectExternalMetrics:input_type -> collector.CollectExternalMetricsRequest
+	15, // 70: collector.Collector.InitLoad:input_type -> collector.InitLoadRequest
+	17, // 71: collector.Collector.SetKnobs:input_type -> collector.SetKnobsRequest
+	20, // 72: collector.Collector.CreateKnobSnapshot:input_type -> collector.CreateKnobSnapshotRequest
+	22, // 73: collector.Collector.ListKnobSnapshots:input_type -> collector.ListKnobSnapshotsRequest
+	24, // 74: collector.Collector.RestoreKnobSnapshot:input_type -> collector.RestoreKnobSnapshotRequest
+	26, // 75: collector.Collector.ResetKnobs:input_type -> collector.ResetKnobsRequest
+	28, // 76: collector.Collector.CollectTopStatements:input_type -> collector.CollectTopStatementsRequest
+	31, // 77: collector.Collector.CollectBlockingTree:input_type -> collector.CollectBlockingTreeRequest
+	33, // 78: collector.Collector.CollectWaitEvents:input_type -> collector.CollectWaitEventsRequest
+	35, // 79: collector.Collector.CollectReplication:input_type -> collector.CollectReplicationRequest
+	37, // 80: collector.Collector.CollectAutovacuumState:input_type -> collector.CollectAutovacuumStateRequest
+	39, // 81: collector.Collector.RecommendTableOptions:input_type -> collector.RecommendTableOptionsRequest
+	41, // 82: collector.Collector.SetTableOptions:input_type -> collector.SetTableOptionsRequest
+	43, // 83: collector.Collector.RecommendIndexes:input_type -> collector.RecommendIndexesRequest
+	46, // 84: collector.Collector.StartMaintenance:input_type -> collector.StartMaintenanceRequest
+	48, // 85: collector.Collector.GetMaintenanceJob:input_type -> collector.GetMaintenanceJobRequest
+	50, // 86: collector.Collector.ListMaintenanceJobs:input_type -> collector.ListMaintenanceJobsRequest
+	52, // 87: collector.Collector.CancelMaintenanceJob:input_type -> collector.CancelMaintenanceJobRequest
+	54, // 88: collector.Collector.ListMetricSamples:input_type -> collector.ListMetricSamplesRequest
+	56, // 89: collector.Collector.ListKnobSamples:input_type -> collector.ListKnobSamplesRequest
+	8,  // 90: collector.Collector.CollectKnobs:output_type -> collector.CollectKnobsResponse
+	10, // 91: collector.Collector.CollectInternalMetrics:output_type -> collector.CollectInternalMetricsResponse
+	12, // 92: collector.Collector.StreamInternalMetrics:output_type -> collector.StreamInternalMetricsResponse
+	14, // 93: collector.Collector.CollectExternalMetrics:output_type -> collector.CollectExternalMetricsResponse
+	16, // 94: collector.Collector.InitLoad:output_type -> collector.InitLoadResponse
+	18, // 95: collector.Collector.SetKnobs:output_type -> collector.SetKnobsResponse
+	21, // 96: collector.Collector.CreateKnobSnapshot:output_type -> collector.CreateKnobSnapshotResponse
+	23, // 97: collector.Collector.ListKnobSnapshots:output_type -> collector.ListKnobSnapshotsResponse
+	25, // 98: collector.Collector.RestoreKnobSnapshot:output_type -> collector.RestoreKnobSnapshotResponse
+	27, // 99: collector.Collector.ResetKnobs:output_type -> collector.ResetKnobsResponse
+	29, // 100: collector.Collector.CollectTopStatements:output_type -> collector.CollectTopStatementsResponse
+	32, // 101: collector.Collector.CollectBlockingTree:output_type -> collector.CollectBlockingTreeResponse
+	34, // 102: collector.Collector.CollectWaitEvents:output_type -> collector.CollectWaitEventsResponse
+	36, // 103: collector.Collector.CollectReplication:output_type -> collector.CollectReplicationResponse
+	38, // 104: collector.Collector.CollectAutovacuumState:output_type -> collector.CollectAutovacuumStateResponse
+	40, // 105: collector.Collector.RecommendTableOptions:output_type -> collector.RecommendTableOptionsResponse
+	42, // 106: collector.Collector.SetTableOptions:output_type -> collector.SetTableOptionsResponse
+	44, // 107: collector.Collector.RecommendIndexes:output_type -> collector.RecommendIndexesResponse
+	47, // 108: collector.Collector.StartMaintenance:output_type -> collector.StartMaintenanceResponse
+	49, // 109: collector.Collector.GetMaintenanceJob:output_type -> collector.GetMaintenanceJobResponse
+	51, // 110: collector.Collector.ListMaintenanceJobs:output_type -> collector.ListMaintenanceJobsResponse
+	53, // 111: collector.Collector.CancelMaintenanceJob:output_type -> collector.CancelMaintenanceJobResponse
+	55, // 112: collector.Collector.ListMetricSamples:output_type -> collector.ListMetricSamplesResponse
+	57, // 113: collector.Collector.ListKnobSamples:output_type -> collector.ListKnobSamplesResponse
+	90, // [90:114] is the sub-list for method output_type
+	66, // [66:90] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_collector_collector_proto_init() }
+func file_collector_collector_proto_init() {
+	if File_collector_collector_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_collector_collector_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectKnobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectKnobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectInternalMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectInternalMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInternalMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInternalMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectExternalMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectExternalMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitLoadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitLoadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKnobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKnobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KnobSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKnobSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKnobSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKnobSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKnobSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreKnobSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreKnobSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetKnobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetKnobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectTopStatementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectTopStatementsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockingNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectBlockingTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectBlockingTreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectWaitEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectWaitEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectReplicationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectReplicationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAutovacuumStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAutovacuumStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTableOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTableOptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTableOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTableOptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendIndexesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -893,8 +6856,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectKnobsResponse); i {
+		file_collector_collector_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendIndexesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -905,8 +6868,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectInternalMetricsRequest); i {
+		file_collector_collector_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,8 +6880,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectInternalMetricsResponse); i {
+		file_collector_collector_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMaintenanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -929,8 +6892,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectExternalMetricsRequest); i {
+		file_collector_collector_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMaintenanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -941,8 +6904,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectExternalMetricsResponse); i {
+		file_collector_collector_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -953,8 +6916,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitLoadRequest); i {
+		file_collector_collector_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,8 +6928,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitLoadResponse); i {
+		file_collector_collector_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -977,8 +6940,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetKnobsRequest); i {
+		file_collector_collector_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -989,8 +6952,8 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetKnobsResponse); i {
+		file_collector_collector_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1001,7 +6964,67 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_collector_collector_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetricSamplesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetricSamplesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKnobSamplesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKnobSamplesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectKnobsResponse_Knob); i {
 			case 0:
 				return &v.state
@@ -1013,7 +7036,7 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_collector_collector_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectInternalMetricsResponse_Metric); i {
 			case 0:
 				return &v.state
@@ -1025,7 +7048,19 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
-		file_collector_collector_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_collector_collector_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectExternalMetricsRequest_Script); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetKnobsRequest_Knob); i {
 			case 0:
 				return &v.state
@@ -1037,29 +7072,240 @@ func file_collector_collector_proto_init() {
 				return nil
 			}
 		}
+		file_collector_collector_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKnobsResponse_Knob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectTopStatementsResponse_Statement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectWaitEventsResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectReplicationResponse_Replica); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectReplicationResponse_Slot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAutovacuumStateResponse_Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAutovacuumStateResponse_Relation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAutovacuumStateResponse_Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTableOptionsResponse_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTableOptionsResponse_Table); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTableOptionsRequest_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTableOptionsRequest_Table); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTableOptionsResponse_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendIndexesResponse_Recommendation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMaintenanceRequest_Relation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetricSamplesResponse_Sample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collector_collector_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKnobSamplesResponse_Sample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_collector_collector_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_collector_collector_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_collector_collector_proto_msgTypes[51].OneofWrappers = []interface{}{
 		(*CollectKnobsResponse_Knob_StrValue)(nil),
 		(*CollectKnobsResponse_Knob_FloatValue)(nil),
 		(*CollectKnobsResponse_Knob_BoolValue)(nil),
 	}
-	file_collector_collector_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_collector_collector_proto_msgTypes[52].OneofWrappers = []interface{}{
 		(*CollectInternalMetricsResponse_Metric_StrValue)(nil),
 		(*CollectInternalMetricsResponse_Metric_FloatValue)(nil),
 		(*CollectInternalMetricsResponse_Metric_BoolValue)(nil),
 	}
+	file_collector_collector_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*SetKnobsRequest_Knob_FloatValue)(nil),
+		(*SetKnobsRequest_Knob_StrValue)(nil),
+		(*SetKnobsRequest_Knob_BoolValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_collector_collector_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      7,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_collector_collector_proto_goTypes,
 		DependencyIndexes: file_collector_collector_proto_depIdxs,
+		EnumInfos:         file_collector_collector_proto_enumTypes,
 		MessageInfos:      file_collector_collector_proto_msgTypes,
 	}.Build()
 	File_collector_collector_proto = out.File
@@ -26,7 +26,7 @@ func main() {
 		discovery          = discovery_adapter.New(discoveryClient)
 		connectionProvider = connections.New(discovery)
 		metricsSelector    = selector.New(connectionProvider)
-		metricsSetter      = setter.New(connectionProvider)
+		metricsSetter      = setter.New(connectionProvider, discovery)
 		app                = environment.New(metricsSelector, metricsSetter)
 	)
 
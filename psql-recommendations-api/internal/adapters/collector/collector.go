@@ -2,7 +2,10 @@ package collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"psqlRecommendationsApi/cmd/clients"
 	"psqlRecommendationsApi/internal/model"
 	desc "psqlRecommendationsApi/pkg/collector"
@@ -11,12 +14,16 @@ import (
 
 type Adapter interface {
 	InitLoad(ctx context.Context) error
-	SetKnobs(ctx context.Context, knobs []model.Knob) error
+	SetKnobs(ctx context.Context, knobs []KnobValue) ([]model.KnobApplyResult, error)
 	CollectExternalMetrics(ctx context.Context) (ExternalMetrics, error)
 	CollectInternalMetrics(ctx context.Context) ([]InternalMetrics, error)
 	CollectKnobs(ctx context.Context) ([]Knob, error)
+	CreateKnobSnapshot(ctx context.Context, name string) error
+	RestoreKnobSnapshot(ctx context.Context, name string) ([]model.KnobApplyResult, error)
 }
 
+var ErrSnapshotAlreadyExists = errors.New("knob snapshot already exists")
+
 type Implementation struct {
 	collectorClient *clients.CollectorClient
 }
@@ -47,36 +54,106 @@ func (i *Implementation) CollectKnobs(ctx context.Context) ([]Knob, error) {
 	knobs := resp.GetKnobs()
 	modelKnobs := make([]Knob, 0, len(knobs))
 	for _, knob := range knobs {
-		modelKnobs = append(modelKnobs, Knob{
-			Name:   knob.GetName(),
-			Value:  float64(knob.GetFloatValue()),
-			MaxVal: float64(knob.GetMaxValue()),
-			MinVal: float64(knob.GetMinValue()),
-		})
+		modelKnob := Knob{
+			Name:     knob.GetName(),
+			VarType:  knob.GetVartype(),
+			Unit:     knob.GetUnit(),
+			MaxVal:   knob.GetMaxValue(),
+			MinVal:   knob.GetMinValue(),
+			EnumVals: knob.GetEnumValues(),
+
+			Context:        knob.GetContext(),
+			PendingRestart: knob.GetPendingRestart(),
+		}
+		switch v := knob.Value.(type) {
+		case *desc.CollectKnobsResponse_Knob_FloatValue:
+			modelKnob.Value = v.FloatValue
+		case *desc.CollectKnobsResponse_Knob_StrValue:
+			modelKnob.Value = v.StrValue
+		case *desc.CollectKnobsResponse_Knob_BoolValue:
+			modelKnob.Value = v.BoolValue
+		}
+		modelKnobs = append(modelKnobs, modelKnob)
 	}
 
 	return modelKnobs, nil
 }
 
-func (i *Implementation) SetKnobs(ctx context.Context, knobs []model.Knob) error {
+func (i *Implementation) SetKnobs(ctx context.Context, knobs []KnobValue) ([]model.KnobApplyResult, error) {
 	descActions := make([]*desc.SetKnobsRequest_Knob, 0, len(knobs))
 	for _, knob := range knobs {
-		descActions = append(descActions, &desc.SetKnobsRequest_Knob{
-			Name:  knob.Name,
-			Value: float32(knob.Value),
-		})
+		descKnob := &desc.SetKnobsRequest_Knob{Name: knob.Name}
+		switch v := knob.Value.(type) {
+		case float64:
+			descKnob.Value = &desc.SetKnobsRequest_Knob_FloatValue{FloatValue: v}
+		case string:
+			descKnob.Value = &desc.SetKnobsRequest_Knob_StrValue{StrValue: v}
+		case bool:
+			descKnob.Value = &desc.SetKnobsRequest_Knob_BoolValue{BoolValue: v}
+		default:
+			return nil, fmt.Errorf("unsupported value type %T for knob %s", knob.Value, knob.Name)
+		}
+		descActions = append(descActions, descKnob)
 	}
 
-	_, err := i.collectorClient.Client.SetKnobs(ctx, &desc.SetKnobsRequest{
+	resp, err := i.collectorClient.Client.SetKnobs(ctx, &desc.SetKnobsRequest{
 		Knobs: descActions,
 	})
 	if err != nil {
-		return fmt.Errorf("collectorClient.Client.SetKnobs: %w", err)
+		return nil, fmt.Errorf("collectorClient.Client.SetKnobs: %w", err)
+	}
+
+	return toModelKnobApplyResults(resp.GetKnobs()), nil
+}
+
+func (i *Implementation) CreateKnobSnapshot(ctx context.Context, name string) error {
+	_, err := i.collectorClient.Client.CreateKnobSnapshot(ctx, &desc.CreateKnobSnapshotRequest{Name: name})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return fmt.Errorf("%w: %s", ErrSnapshotAlreadyExists, name)
+		}
+		return fmt.Errorf("collectorClient.Client.CreateKnobSnapshot: %w", err)
 	}
 
 	return nil
 }
 
+func (i *Implementation) RestoreKnobSnapshot(ctx context.Context, name string) ([]model.KnobApplyResult, error) {
+	resp, err := i.collectorClient.Client.RestoreKnobSnapshot(ctx, &desc.RestoreKnobSnapshotRequest{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("collectorClient.Client.RestoreKnobSnapshot: %w", err)
+	}
+
+	return toModelKnobApplyResults(resp.GetKnobs()), nil
+}
+
+func toModelKnobApplyResults(knobs []*desc.SetKnobsResponse_Knob) []model.KnobApplyResult {
+	results := make([]model.KnobApplyResult, 0, len(knobs))
+	for _, knob := range knobs {
+		results = append(results, model.KnobApplyResult{
+			Name:           knob.GetName(),
+			RequestedValue: knob.GetRequestedValue(),
+			EffectiveValue: knob.GetEffectiveValue(),
+			Status:         toModelKnobApplyStatus(knob.GetStatus()),
+			Error:          knob.GetError(),
+		})
+	}
+	return results
+}
+
+func toModelKnobApplyStatus(status desc.KnobApplyStatus) model.KnobApplyStatus {
+	switch status {
+	case desc.KnobApplyStatus_Applied:
+		return model.KnobApplied
+	case desc.KnobApplyStatus_PendingRestart:
+		return model.KnobPendingRestart
+	case desc.KnobApplyStatus_Rejected:
+		return model.KnobRejected
+	default:
+		return model.KnobApplyStatusUnspecified
+	}
+}
+
 func (i *Implementation) CollectExternalMetrics(ctx context.Context) (ExternalMetrics, error) {
 	resp, err := i.collectorClient.Client.CollectExternalMetrics(ctx, &desc.CollectExternalMetricsRequest{})
 	if err != nil {
@@ -86,7 +163,10 @@ func (i *Implementation) CollectExternalMetrics(ctx context.Context) (ExternalMe
 }
 
 func (i *Implementation) CollectInternalMetrics(ctx context.Context) ([]InternalMetrics, error) {
-	resp, err := i.collectorClient.Client.CollectInternalMetrics(ctx, &desc.CollectInternalMetricsRequest{})
+	// Counters are requested as rates so that states of different steps are comparable
+	resp, err := i.collectorClient.Client.CollectInternalMetrics(ctx, &desc.CollectInternalMetricsRequest{
+		Mode: desc.MetricsMode_Rate,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("collectorClient.CollectInternalMetrics: %w", err)
 	}
@@ -10,9 +10,23 @@ type InternalMetrics struct {
 	Value float64
 }
 
+// Knob holds float64 for integer and real knobs, bool for bool knobs
+// and string for string and enum knobs.
 type Knob struct {
-	Name   string
-	Value  float64
-	MinVal float64
-	MaxVal float64
+	Name     string
+	VarType  string
+	Unit     string
+	Value    interface{}
+	MinVal   float64
+	MaxVal   float64
+	EnumVals []string
+
+	Context        string
+	PendingRestart bool
+}
+
+// KnobValue is a typed value to be applied by SetKnobs.
+type KnobValue struct {
+	Name  string
+	Value interface{}
 }
@@ -11,6 +11,7 @@ import (
 type Adapter interface {
 	RegisterInstance(ctx context.Context, instanceName string, config []byte) (model.CollectorInstance, error)
 	GetInstanceInfo(ctx context.Context, instanceName string) (model.CollectorInstance, error)
+	RestartInstance(ctx context.Context, instanceName string) error
 }
 
 type Implementation struct {
@@ -55,3 +56,14 @@ func (i *Implementation) GetInstanceInfo(ctx context.Context, instanceName strin
 		Port: int(instance.Port),
 	}, nil
 }
+
+func (i *Implementation) RestartInstance(ctx context.Context, instanceName string) error {
+	_, err := i.client.Client.RestartInstance(ctx, &desc.RestartInstanceRequest{
+		InstanceName: instanceName,
+	})
+	if err != nil {
+		return fmt.Errorf("client.RestartInstance: %w", err)
+	}
+
+	return nil
+}
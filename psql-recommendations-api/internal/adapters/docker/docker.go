@@ -3,21 +3,34 @@ package docker
 import (
 	"context"
 	"fmt"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 	"gopkg.in/yaml.v3"
 	"psqlRecommendationsApi/cmd/clients"
 	model "psqlRecommendationsApi/internal/model/discovery"
 	"strconv"
+	"strings"
+	"time"
 )
 
-const imageName = "vladmsnk/psql-collector:latest"
+const (
+	imageName = "vladmsnk/psql-collector:latest"
+
+	restartTimeout        = 2 * time.Minute
+	readinessPollInterval = time.Second
+
+	containerNameEnv = "PG_CONTAINER_NAME"
+)
 
 type Adapter interface {
 	CreateInstance(ctx context.Context, instanceName string, config []byte) (model.CollectorInstance, error)
+	RestartContainer(ctx context.Context, containerName string) error
+	GetPostgresContainer(ctx context.Context, collectorContainerID string) (string, error)
 }
 
 type Implementation struct {
@@ -42,10 +55,11 @@ func (i *Implementation) CreateInstance(ctx context.Context, instanceName string
 		},
 	}
 
-	envs, err := GetEnsFromConfig(config)
+	pgConfig, err := parseConfig(config)
 	if err != nil {
-		return model.CollectorInstance{}, fmt.Errorf("GetEnsFromConfig: %w", err)
+		return model.CollectorInstance{}, fmt.Errorf("parseConfig: %w", err)
 	}
+	envs := getEnvsFromConfig(pgConfig)
 
 	configuration := &container.Config{
 		ExposedPorts: map[nat.Port]struct{}{
@@ -81,15 +95,88 @@ func (i *Implementation) CreateInstance(ctx context.Context, instanceName string
 		return model.CollectorInstance{}, err
 	}
 
-	return model.CollectorInstance{Id: containerId, Host: instanceName, Port: 7002, Name: instanceName}, nil
+	return model.CollectorInstance{Id: containerId, Host: instanceName, Port: 7002, Name: instanceName, PostgresContainer: pgConfig.ContainerName}, nil
 }
 
-func GetEnsFromConfig(config []byte) ([]string, error) {
+// RestartContainer restarts the container and waits until it is running and,
+// if the container defines a healthcheck, healthy.
+func (i *Implementation) RestartContainer(ctx context.Context, containerName string) error {
+	if containerName == "" {
+		return fmt.Errorf("container name should not be empty")
+	}
+
+	err := i.dockerClient.Client.ContainerRestart(ctx, containerName, container.StopOptions{})
+	if err != nil {
+		return fmt.Errorf("dockerClient.Client.ContainerRestart: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, restartTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+
+	for {
+		info, err := i.dockerClient.Client.ContainerInspect(ctx, containerName)
+		if err != nil {
+			return fmt.Errorf("dockerClient.Client.ContainerInspect: %w", err)
+		}
+		if isReady(info) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("container %s: %w", containerName, model.ErrInstanceNotReady)
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetPostgresContainer reads the PostgreSQL container name from the environment of the collector container.
+// It is used for instances registered before the name was stored with the instance.
+func (i *Implementation) GetPostgresContainer(ctx context.Context, collectorContainerID string) (string, error) {
+	info, err := i.dockerClient.Client.ContainerInspect(ctx, collectorContainerID)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return "", fmt.Errorf("collector container %s: %w", collectorContainerID, model.ErrPostgresContainerUnknown)
+		}
+		return "", fmt.Errorf("dockerClient.Client.ContainerInspect: %w", err)
+	}
+	if info.Config == nil {
+		return "", model.ErrPostgresContainerUnknown
+	}
+
+	for _, env := range info.Config.Env {
+		// older collectors were created with the variable missing "=", as in PG_CONTAINER_NAMEpostgres
+		name, ok := strings.CutPrefix(env, containerNameEnv)
+		if !ok {
+			continue
+		}
+		if name = strings.TrimPrefix(name, "="); name != "" {
+			return name, nil
+		}
+	}
+	return "", model.ErrPostgresContainerUnknown
+}
+
+func isReady(info types.ContainerJSON) bool {
+	if info.State == nil || !info.State.Running {
+		return false
+	}
+	return info.State.Health == nil || info.State.Health.Status == types.Healthy
+}
+
+func parseConfig(config []byte) (Postgres, error) {
 	p := Postgres{}
 	err := yaml.Unmarshal(config, &p)
 	if err != nil {
-		return nil, err
+		return Postgres{}, err
 	}
+	return p, nil
+}
+
+func getEnvsFromConfig(p Postgres) []string {
 	envs := []string{
 		"PG_USER=" + p.User,
 		"PG_PASSWORD=" + p.Password,
@@ -97,7 +184,7 @@ func GetEnsFromConfig(config []byte) ([]string, error) {
 		"PG_SSLMODE=" + p.SSLMode,
 		"PG_HOST=" + p.Host,
 		"PG_PORT=" + strconv.Itoa(p.Port),
-		"PG_CONTAINER_NAME" + p.ContainerName,
+		containerNameEnv + "=" + p.ContainerName,
 	}
-	return envs, nil
+	return envs
 }
@@ -14,6 +14,7 @@ import (
 type Registrator interface {
 	RegisterInstance(ctx context.Context, instanceName string, config []byte) (model.CollectorInstance, error)
 	GetInstanceInfo(ctx context.Context, instanceName string) (model.CollectorInstance, error)
+	RestartInstance(ctx context.Context, instanceName string) error
 }
 
 type Delivery struct {
@@ -77,3 +78,23 @@ func (d *Delivery) GetInstanceInfo(ctx context.Context, req *desc.GetInstanceInf
 		Port:         int64(instanceInfo.Port),
 	}, nil
 }
+
+func (d *Delivery) RestartInstance(ctx context.Context, req *desc.RestartInstanceRequest) (*desc.RestartInstanceResponse, error) {
+	instanceName := req.GetInstanceName()
+	if instanceName == "" {
+		return nil, status.Error(codes.InvalidArgument, "instance_name should not be empty")
+	}
+
+	err := d.registrator.RestartInstance(ctx, instanceName)
+	if err != nil {
+		if errors.Is(err, model.ErrInstanceNotReady) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		if errors.Is(err, model.ErrPostgresContainerUnknown) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("registrator.RestartInstance: %w", err)
+	}
+
+	return &desc.RestartInstanceResponse{}, nil
+}
@@ -30,7 +30,7 @@ type Selector interface {
 }
 
 type Setter interface {
-	SetActions(ctx context.Context, instanceName string, actions []model.Action) error
+	SetActions(ctx context.Context, instanceName string, actions []model.Action) ([]model.KnobApplyResult, error)
 	InitEnvironment(ctx context.Context, instanceName string) error
 }
 
@@ -87,16 +87,39 @@ func (d *Delivery) ApplyActions(ctx context.Context, req *desc.ApplyActionsReque
 	knobsToApply := lo.Map(req.GetActions(), func(action *desc.ApplyActionsRequest_Action, _ int) model.Action {
 		return model.Action{
 			Name:  action.GetName(),
-			Value: float64(action.GetValue()),
+			Value: action.GetValue(),
 		}
 	})
 
-	err := d.setter.SetActions(ctx, instanceName, knobsToApply)
+	results, err := d.setter.SetActions(ctx, instanceName, knobsToApply)
 	if err != nil {
 		return nil, fmt.Errorf("setter.ApplyActions: %w", err)
 	}
 
-	return &desc.ApplyActionsResponse{}, nil
+	descResults := lo.Map(results, func(result model.KnobApplyResult, _ int) *desc.ApplyActionsResponse_Knob {
+		return &desc.ApplyActionsResponse_Knob{
+			Name:           result.Name,
+			RequestedValue: result.RequestedValue,
+			EffectiveValue: result.EffectiveValue,
+			Status:         toDescKnobApplyStatus(result.Status),
+			Error:          result.Error,
+		}
+	})
+
+	return &desc.ApplyActionsResponse{Knobs: descResults}, nil
+}
+
+func toDescKnobApplyStatus(applyStatus model.KnobApplyStatus) desc.KnobApplyStatus {
+	switch applyStatus {
+	case model.KnobApplied:
+		return desc.KnobApplyStatus_Applied
+	case model.KnobPendingRestart:
+		return desc.KnobApplyStatus_PendingRestart
+	case model.KnobRejected:
+		return desc.KnobApplyStatus_Rejected
+	default:
+		return desc.KnobApplyStatus_Unspecified
+	}
 }
 
 func (d *Delivery) GetActionState(ctx context.Context, req *desc.GetActionStateRequest) (*desc.GetActionStateResponse, error) {
@@ -122,9 +145,10 @@ func (d *Delivery) GetActionState(ctx context.Context, req *desc.GetActionStateR
 	descKnobs := lo.Map(knobs, func(knob model.Knob, _ int) *desc.GetActionStateResponse_Knob {
 		return &desc.GetActionStateResponse_Knob{
 			Name:     knob.Name,
-			Value:    float32(knob.Value),
-			MaxValue: float32(knob.MaxVal),
-			MinValue: float32(knob.MinVal),
+			Value:    knob.Value,
+			MaxValue: knob.MaxVal,
+			MinValue: knob.MinVal,
+			Unit:     knob.Unit,
 		}
 	})
 
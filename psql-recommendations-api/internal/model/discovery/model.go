@@ -4,6 +4,10 @@ import "errors"
 
 var (
 	ErrInstanceAlreadyExists = errors.New("instance already exists")
+	ErrInstanceNotReady      = errors.New("instance is not ready")
+	// ErrPostgresContainerUnknown is returned when the PostgreSQL container of an instance can not be found,
+	// such an instance has to be registered again with container_name in its config.
+	ErrPostgresContainerUnknown = errors.New("postgres container of the instance is unknown, register the instance again with container_name set")
 )
 
 type InstanceStatus int64
@@ -20,4 +24,7 @@ type CollectorInstance struct {
 	Host   string         `json:"host"`
 	Port   int            `json:"port"`
 	Status InstanceStatus `json:"status"`
+
+	// PostgresContainer is the name of the container running the tuned PostgreSQL
+	PostgresContainer string `json:"postgres_container"`
 }
@@ -15,11 +15,49 @@ type ExternalMetrics struct {
 	Latency float64
 }
 
+const (
+	VarTypeBool    = "bool"
+	VarTypeInteger = "integer"
+	VarTypeReal    = "real"
+	VarTypeString  = "string"
+	VarTypeEnum    = "enum"
+)
+
+// Canonical units of knob values.
+const (
+	UnitBytes        = "B"
+	UnitMilliseconds = "ms"
+)
+
+// Knob is a knob as seen by the model. Bool knobs are represented as 0/1
+// and enum knobs as the index of the value in EnumVals. Numeric values
+// and bounds are in canonical units: bytes or milliseconds.
 type Knob struct {
-	Name   string
-	Value  float64
-	MinVal float64
-	MaxVal float64
+	Name     string
+	VarType  string
+	Unit     string
+	Value    float64
+	MinVal   float64
+	MaxVal   float64
+	EnumVals []string
+}
+
+type KnobApplyStatus int
+
+const (
+	KnobApplyStatusUnspecified KnobApplyStatus = iota
+	KnobApplied
+	KnobPendingRestart
+	KnobRejected
+)
+
+// KnobApplyResult is the outcome of applying a single action.
+type KnobApplyResult struct {
+	Name           string
+	RequestedValue string
+	EffectiveValue string
+	Status         KnobApplyStatus
+	Error          string
 }
 
 type Action struct {
@@ -8,4 +8,5 @@ import (
 type Registrator interface {
 	RegisterInstance(ctx context.Context, instanceName string, config []byte) (model.CollectorInstance, error)
 	GetInstanceInfo(ctx context.Context, instanceName string) (model.CollectorInstance, error)
+	RestartInstance(ctx context.Context, instanceName string) error
 }
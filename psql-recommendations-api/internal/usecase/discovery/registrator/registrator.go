@@ -15,6 +15,8 @@ type Storage interface {
 
 type InstanceCreator interface {
 	CreateInstance(ctx context.Context, instanceName string, config []byte) (model.CollectorInstance, error)
+	RestartContainer(ctx context.Context, containerName string) error
+	GetPostgresContainer(ctx context.Context, collectorContainerID string) (string, error)
 }
 
 type Implementation struct {
@@ -60,3 +62,30 @@ func (i *Implementation) GetInstanceInfo(ctx context.Context, instanceName strin
 
 	return instance, nil
 }
+
+func (i *Implementation) RestartInstance(ctx context.Context, instanceName string) error {
+	instance, err := i.storage.GetInstance(ctx, instanceName)
+	if err != nil {
+		return fmt.Errorf("storage.GetInstance: %w", err)
+	}
+
+	if instance.PostgresContainer == "" {
+		// the instance was registered before the container name was stored, recover and save it
+		instance.PostgresContainer, err = i.instanceCreator.GetPostgresContainer(ctx, instance.Id)
+		if err != nil {
+			return fmt.Errorf("instanceCreator.GetPostgresContainer: %w", err)
+		}
+
+		err = i.storage.SaveInstance(ctx, instance)
+		if err != nil {
+			return fmt.Errorf("storage.SaveInstance: %w", err)
+		}
+	}
+
+	err = i.instanceCreator.RestartContainer(ctx, instance.PostgresContainer)
+	if err != nil {
+		return fmt.Errorf("instanceCreator.RestartContainer: %w", err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"psqlRecommendationsApi/internal/adapters/collector"
 	"psqlRecommendationsApi/internal/adapters/connections"
 	"psqlRecommendationsApi/internal/model"
+	"slices"
 )
 
 type Selector interface {
@@ -77,12 +78,42 @@ func (i *Implementation) ListKnobs(ctx context.Context, instanceName string) ([]
 		return nil, fmt.Errorf("collector.CollectKnobs: %w", err)
 	}
 	for _, knob := range knobs {
-		res = append(res, model.Knob{Name: knob.Name, Value: knob.Value, MinVal: knob.MinVal, MaxVal: knob.MaxVal})
+		res = append(res, toModelKnob(knob))
 	}
 
 	return res, nil
 }
 
+// toModelKnob maps a collected knob onto the numeric action space:
+// bool knobs become 0/1 and enum knobs become the index of the current value.
+func toModelKnob(knob collector.Knob) model.Knob {
+	modelKnob := model.Knob{
+		Name:     knob.Name,
+		VarType:  knob.VarType,
+		Unit:     knob.Unit,
+		MinVal:   knob.MinVal,
+		MaxVal:   knob.MaxVal,
+		EnumVals: knob.EnumVals,
+	}
+
+	switch v := knob.Value.(type) {
+	case float64:
+		modelKnob.Value = v
+	case bool:
+		modelKnob.MinVal, modelKnob.MaxVal = 0, 1
+		if v {
+			modelKnob.Value = 1
+		}
+	case string:
+		if knob.VarType == model.VarTypeEnum && len(knob.EnumVals) > 0 {
+			modelKnob.MinVal, modelKnob.MaxVal = 0, float64(len(knob.EnumVals)-1)
+			modelKnob.Value = float64(slices.Index(knob.EnumVals, v))
+		}
+	}
+
+	return modelKnob
+}
+
 func (i *Implementation) getCollectorAdapter(ctx context.Context, instanceName string) (collector.Adapter, error) {
 	connection, err := i.connectionProvider.GetConnection(ctx, instanceName)
 	if err != nil {
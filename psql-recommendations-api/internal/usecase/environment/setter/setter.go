@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"math"
 	"psqlRecommendationsApi/cmd/clients"
 	"psqlRecommendationsApi/internal/adapters/collector"
 	"psqlRecommendationsApi/internal/adapters/connections"
 	"psqlRecommendationsApi/internal/model"
+	"slices"
+	"strconv"
+	"time"
 )
 
 type Setter interface {
-	SetActions(ctx context.Context, instanceName string, actions []model.Action) error
+	SetActions(ctx context.Context, instanceName string, actions []model.Action) ([]model.KnobApplyResult, error)
 	InitEnvironment(ctx context.Context, instanceName string) error
 }
 
@@ -20,34 +25,233 @@ type ConnectionProvider interface {
 	SetConnection(_ context.Context, instanceName string) error
 }
 
+type Restarter interface {
+	RestartInstance(ctx context.Context, instanceName string) error
+}
+
+const (
+	restartVerifyTimeout  = 2 * time.Minute
+	restartVerifyInterval = 2 * time.Second
+
+	// knobValueTolerance is a relative tolerance used when comparing applied numeric values,
+	// PostgreSQL rounds them to the knob's own unit (e.g. 8kB pages for shared_buffers).
+	knobValueTolerance = 0.01
+	blockSize          = 8192
+
+	// baselineSnapshot holds knobs captured before the first episode, every episode starts from it.
+	baselineSnapshot = "baseline"
+)
+
+var ErrKnobsNotApplied = errors.New("knobs were not applied after restart")
+
 type Implementation struct {
 	connectionProvider ConnectionProvider
+	restarter          Restarter
 }
 
-func New(connectionProvider ConnectionProvider) *Implementation {
+func New(connectionProvider ConnectionProvider, restarter Restarter) *Implementation {
 	return &Implementation{
 		connectionProvider: connectionProvider,
+		restarter:          restarter,
 	}
 }
 
-func (i *Implementation) SetActions(ctx context.Context, instanceName string, actions []model.Action) error {
-	var knobs []model.Knob
+func (i *Implementation) SetActions(ctx context.Context, instanceName string, actions []model.Action) ([]model.KnobApplyResult, error) {
+	collectorAdapter, err := i.getCollectorAdapter(ctx, instanceName)
+	if err != nil {
+		return nil, fmt.Errorf("i.getCollectorAdapter: %w", err)
+	}
+
+	collectedKnobs, err := collectorAdapter.CollectKnobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collector.CollectKnobs: %w", err)
+	}
+	knobsByName := make(map[string]collector.Knob, len(collectedKnobs))
+	for _, knob := range collectedKnobs {
+		knobsByName[knob.Name] = knob
+	}
+
+	var knobs []collector.KnobValue
 	for _, action := range actions {
-		knobs = append(knobs, model.Knob{Name: action.Name, Value: action.Value})
+		knob, ok := knobsByName[action.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown knob %s", action.Name)
+		}
+
+		value, err := toKnobValue(knob, action.Value)
+		if err != nil {
+			return nil, fmt.Errorf("toKnobValue: %w", err)
+		}
+		knobs = append(knobs, collector.KnobValue{Name: action.Name, Value: value})
 	}
 
-	collectorAdapter, err := i.getCollectorAdapter(ctx, instanceName)
+	results, err := collectorAdapter.SetKnobs(ctx, knobs)
 	if err != nil {
-		return fmt.Errorf("i.getCollectorAdapter: %w", err)
+		return nil, fmt.Errorf("setter.SetKnobs: %w", err)
+	}
+
+	err = i.restartIfPending(ctx, instanceName, collectorAdapter, knobs, results)
+	if err != nil {
+		return nil, fmt.Errorf("i.restartIfPending: %w", err)
 	}
 
-	err = collectorAdapter.SetKnobs(ctx, knobs)
+	return results, nil
+
+}
+
+// restartIfPending restarts the instance when any of the applied knobs is pending restart,
+// waits until the restarted server reports the requested values and marks them as applied
+// with the values read back from the server.
+func (i *Implementation) restartIfPending(ctx context.Context, instanceName string, collectorAdapter collector.Adapter, knobs []collector.KnobValue, results []model.KnobApplyResult) error {
+	pending := make(map[string]bool)
+	for _, result := range results {
+		if result.Status == model.KnobPendingRestart {
+			pending[result.Name] = true
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	log.Printf("restarting instance %s to apply postmaster knobs", instanceName)
+	err := i.restarter.RestartInstance(ctx, instanceName)
+	if err != nil {
+		return fmt.Errorf("restarter.RestartInstance: %w", err)
+	}
+
+	pendingKnobs := slices.DeleteFunc(slices.Clone(knobs), func(knob collector.KnobValue) bool {
+		return !pending[knob.Name]
+	})
+	collectedKnobs, err := waitForKnobs(ctx, collectorAdapter, func(collectedKnobs []collector.Knob) error {
+		return verifyKnobs(collectedKnobs, pendingKnobs)
+	})
 	if err != nil {
-		return fmt.Errorf("setter.SetKnobs: %w", err)
+		return fmt.Errorf("waitForKnobs: %w", err)
 	}
 
+	knobsByName := make(map[string]collector.Knob, len(collectedKnobs))
+	for _, knob := range collectedKnobs {
+		knobsByName[knob.Name] = knob
+	}
+	for idx := range results {
+		if pending[results[idx].Name] {
+			results[idx].Status = model.KnobApplied
+			results[idx].EffectiveValue = formatKnobValue(knobsByName[results[idx].Name].Value)
+		}
+	}
 	return nil
+}
+
+// waitForKnobs polls the collector until the server is reachable again and collected knobs pass the check,
+// it returns the knobs that passed.
+func waitForKnobs(ctx context.Context, collectorAdapter collector.Adapter, check func([]collector.Knob) error) ([]collector.Knob, error) {
+	ctx, cancel := context.WithTimeout(ctx, restartVerifyTimeout)
+	defer cancel()
 
+	ticker := time.NewTicker(restartVerifyInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		collectedKnobs, err := collectorAdapter.CollectKnobs(ctx)
+		if err != nil {
+			// server may still be starting up
+			lastErr = err
+		} else {
+			lastErr = check(collectedKnobs)
+			if lastErr == nil {
+				return collectedKnobs, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %v", ErrKnobsNotApplied, lastErr)
+		case <-ticker.C:
+		}
+	}
+}
+
+func verifyKnobs(collectedKnobs []collector.Knob, knobs []collector.KnobValue) error {
+	knobsByName := make(map[string]collector.Knob, len(collectedKnobs))
+	for _, knob := range collectedKnobs {
+		knobsByName[knob.Name] = knob
+	}
+
+	for _, requested := range knobs {
+		actual, ok := knobsByName[requested.Name]
+		if !ok {
+			return fmt.Errorf("knob %s not found", requested.Name)
+		}
+		if actual.PendingRestart {
+			return fmt.Errorf("knob %s is still pending restart", requested.Name)
+		}
+		if !knobValuesMatch(actual, requested.Value) {
+			return fmt.Errorf("knob %s has value %v, expected %v", requested.Name, actual.Value, requested.Value)
+		}
+	}
+	return nil
+}
+
+func verifyNothingPending(collectedKnobs []collector.Knob) error {
+	for _, knob := range collectedKnobs {
+		if knob.PendingRestart {
+			return fmt.Errorf("knob %s is still pending restart", knob.Name)
+		}
+	}
+	return nil
+}
+
+func knobValuesMatch(actual collector.Knob, requested interface{}) bool {
+	requestedFloat, ok := requested.(float64)
+	if !ok {
+		return actual.Value == requested
+	}
+
+	actualFloat, ok := actual.Value.(float64)
+	if !ok {
+		return false
+	}
+	tolerance := knobValueTolerance * math.Abs(requestedFloat)
+	if actual.Unit == model.UnitBytes {
+		tolerance = max(tolerance, blockSize)
+	}
+	return math.Abs(actualFloat-requestedFloat) <= tolerance
+}
+
+// formatKnobValue renders a collected value the way the collector reports effective values of SetKnobs.
+func formatKnobValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		if v {
+			return "on"
+		}
+		return "off"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// toKnobValue converts an action from the numeric action space back to a typed knob value:
+// bool knobs are on when the action is at least 0.5, enum knobs take the value at the rounded index.
+func toKnobValue(knob collector.Knob, action float64) (interface{}, error) {
+	switch knob.VarType {
+	case model.VarTypeBool:
+		return action >= 0.5, nil
+	case model.VarTypeEnum:
+		if len(knob.EnumVals) == 0 {
+			return nil, fmt.Errorf("knob %s has no enum values", knob.Name)
+		}
+		index := int(math.Round(action))
+		index = max(0, min(index, len(knob.EnumVals)-1))
+		return knob.EnumVals[index], nil
+	case model.VarTypeString:
+		return nil, fmt.Errorf("knob %s of type string can not be set from an action", knob.Name)
+	default:
+		return action, nil
+	}
 }
 
 func (i *Implementation) InitEnvironment(ctx context.Context, instanceName string) error {
@@ -57,6 +261,11 @@ func (i *Implementation) InitEnvironment(ctx context.Context, instanceName strin
 		return fmt.Errorf("i.getCollectorAdapter: %w", err)
 	}
 
+	err = i.restoreBaseline(ctx, instanceName, collectorAdapter)
+	if err != nil {
+		return fmt.Errorf("i.restoreBaseline: %w", err)
+	}
+
 	err = collectorAdapter.InitLoad(ctx)
 	if err != nil {
 		return fmt.Errorf("setter.InitLoad: %w", err)
@@ -64,6 +273,39 @@ func (i *Implementation) InitEnvironment(ctx context.Context, instanceName strin
 	return nil
 }
 
+// restoreBaseline captures the baseline snapshot on the first call and restores it on every call,
+// so knobs changed during the previous episode do not leak into the next one.
+func (i *Implementation) restoreBaseline(ctx context.Context, instanceName string, collectorAdapter collector.Adapter) error {
+	err := collectorAdapter.CreateKnobSnapshot(ctx, baselineSnapshot)
+	if err != nil && !errors.Is(err, collector.ErrSnapshotAlreadyExists) {
+		return fmt.Errorf("collector.CreateKnobSnapshot: %w", err)
+	}
+
+	results, err := collectorAdapter.RestoreKnobSnapshot(ctx, baselineSnapshot)
+	if err != nil {
+		return fmt.Errorf("collector.RestoreKnobSnapshot: %w", err)
+	}
+
+	pending := slices.ContainsFunc(results, func(result model.KnobApplyResult) bool {
+		return result.Status == model.KnobPendingRestart
+	})
+	if !pending {
+		return nil
+	}
+
+	log.Printf("restarting instance %s to restore baseline knobs", instanceName)
+	err = i.restarter.RestartInstance(ctx, instanceName)
+	if err != nil {
+		return fmt.Errorf("restarter.RestartInstance: %w", err)
+	}
+
+	_, err = waitForKnobs(ctx, collectorAdapter, verifyNothingPending)
+	if err != nil {
+		return fmt.Errorf("waitForKnobs: %w", err)
+	}
+	return nil
+}
+
 func (i *Implementation) getCollectorAdapter(ctx context.Context, instanceName string) (collector.Adapter, error) {
 	connection, err := i.connectionProvider.GetConnection(ctx, instanceName)
 	if err != nil {
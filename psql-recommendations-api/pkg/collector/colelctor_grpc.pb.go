@@ -21,9 +21,28 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Collector_CollectKnobs_FullMethodName           = "/collector.Collector/CollectKnobs"
 	Collector_CollectInternalMetrics_FullMethodName = "/collector.Collector/CollectInternalMetrics"
+	Collector_StreamInternalMetrics_FullMethodName  = "/collector.Collector/StreamInternalMetrics"
 	Collector_CollectExternalMetrics_FullMethodName = "/collector.Collector/CollectExternalMetrics"
 	Collector_InitLoad_FullMethodName               = "/collector.Collector/InitLoad"
 	Collector_SetKnobs_FullMethodName               = "/collector.Collector/SetKnobs"
+	Collector_CreateKnobSnapshot_FullMethodName     = "/collector.Collector/CreateKnobSnapshot"
+	Collector_ListKnobSnapshots_FullMethodName      = "/collector.Collector/ListKnobSnapshots"
+	Collector_RestoreKnobSnapshot_FullMethodName    = "/collector.Collector/RestoreKnobSnapshot"
+	Collector_ResetKnobs_FullMethodName             = "/collector.Collector/ResetKnobs"
+	Collector_CollectTopStatements_FullMethodName   = "/collector.Collector/CollectTopStatements"
+	Collector_CollectBlockingTree_FullMethodName    = "/collector.Collector/CollectBlockingTree"
+	Collector_CollectWaitEvents_FullMethodName      = "/collector.Collector/CollectWaitEvents"
+	Collector_CollectReplication_FullMethodName     = "/collector.Collector/CollectReplication"
+	Collector_CollectAutovacuumState_FullMethodName = "/collector.Collector/CollectAutovacuumState"
+	Collector_RecommendTableOptions_FullMethodName  = "/collector.Collector/RecommendTableOptions"
+	Collector_SetTableOptions_FullMethodName        = "/collector.Collector/SetTableOptions"
+	Collector_RecommendIndexes_FullMethodName       = "/collector.Collector/RecommendIndexes"
+	Collector_StartMaintenance_FullMethodName       = "/collector.Collector/StartMaintenance"
+	Collector_GetMaintenanceJob_FullMethodName      = "/collector.Collector/GetMaintenanceJob"
+	Collector_ListMaintenanceJobs_FullMethodName    = "/collector.Collector/ListMaintenanceJobs"
+	Collector_CancelMaintenanceJob_FullMethodName   = "/collector.Collector/CancelMaintenanceJob"
+	Collector_ListMetricSamples_FullMethodName      = "/collector.Collector/ListMetricSamples"
+	Collector_ListKnobSamples_FullMethodName        = "/collector.Collector/ListKnobSamples"
 )
 
 // CollectorClient is the client API for Collector service.
@@ -33,10 +52,46 @@ type CollectorClient interface {
 	// Collects PostgreSQL knobs
 	CollectKnobs(ctx context.Context, in *CollectKnobsRequest, opts ...grpc.CallOption) (*CollectKnobsResponse, error)
 	CollectInternalMetrics(ctx context.Context, in *CollectInternalMetricsRequest, opts ...grpc.CallOption) (*CollectInternalMetricsResponse, error)
+	// Sends aggregated metrics every interval until the client cancels, one collection loop serves all subscribers
+	StreamInternalMetrics(ctx context.Context, in *StreamInternalMetricsRequest, opts ...grpc.CallOption) (Collector_StreamInternalMetricsClient, error)
 	CollectExternalMetrics(ctx context.Context, in *CollectExternalMetricsRequest, opts ...grpc.CallOption) (*CollectExternalMetricsResponse, error)
 	InitLoad(ctx context.Context, in *InitLoadRequest, opts ...grpc.CallOption) (*InitLoadResponse, error)
 	// Применяет параметры конфигурации
 	SetKnobs(ctx context.Context, in *SetKnobsRequest, opts ...grpc.CallOption) (*SetKnobsResponse, error)
+	// Saves current values of all tunable knobs under a name
+	CreateKnobSnapshot(ctx context.Context, in *CreateKnobSnapshotRequest, opts ...grpc.CallOption) (*CreateKnobSnapshotResponse, error)
+	ListKnobSnapshots(ctx context.Context, in *ListKnobSnapshotsRequest, opts ...grpc.CallOption) (*ListKnobSnapshotsResponse, error)
+	// Sets knobs that differ from the snapshot back to the saved values
+	RestoreKnobSnapshot(ctx context.Context, in *RestoreKnobSnapshotRequest, opts ...grpc.CallOption) (*RestoreKnobSnapshotResponse, error)
+	// Removes all ALTER SYSTEM overrides (ALTER SYSTEM RESET ALL)
+	ResetKnobs(ctx context.Context, in *ResetKnobsRequest, opts ...grpc.CallOption) (*ResetKnobsResponse, error)
+	// Returns top statements of pg_stat_statements aggregated per queryid
+	CollectTopStatements(ctx context.Context, in *CollectTopStatementsRequest, opts ...grpc.CallOption) (*CollectTopStatementsResponse, error)
+	// Returns backends waiting for locks arranged by the backends blocking them
+	CollectBlockingTree(ctx context.Context, in *CollectBlockingTreeRequest, opts ...grpc.CallOption) (*CollectBlockingTreeResponse, error)
+	// Returns the histogram of wait events sampled from pg_stat_activity
+	CollectWaitEvents(ctx context.Context, in *CollectWaitEventsRequest, opts ...grpc.CallOption) (*CollectWaitEventsResponse, error)
+	// Returns the role of the server, its replicas and replication slots
+	CollectReplication(ctx context.Context, in *CollectReplicationRequest, opts ...grpc.CallOption) (*CollectReplicationResponse, error)
+	// Returns autovacuum settings, per-table vacuum statistics and long-running transactions
+	CollectAutovacuumState(ctx context.Context, in *CollectAutovacuumStateRequest, opts ...grpc.CallOption) (*CollectAutovacuumStateResponse, error)
+	// Proposes per-table autovacuum settings and fillfactor with a rationale
+	RecommendTableOptions(ctx context.Context, in *RecommendTableOptionsRequest, opts ...grpc.CallOption) (*RecommendTableOptionsResponse, error)
+	// Sets table storage parameters with ALTER TABLE ... SET, all tables are changed or none
+	SetTableOptions(ctx context.Context, in *SetTableOptionsRequest, opts ...grpc.CallOption) (*SetTableOptionsResponse, error)
+	// Reports unused, duplicate, overlapping, invalid and bloated indexes with statements to fix them
+	RecommendIndexes(ctx context.Context, in *RecommendIndexesRequest, opts ...grpc.CallOption) (*RecommendIndexesResponse, error)
+	// Starts VACUUM (ANALYZE), ANALYZE or REINDEX CONCURRENTLY as background jobs, one per relation
+	StartMaintenance(ctx context.Context, in *StartMaintenanceRequest, opts ...grpc.CallOption) (*StartMaintenanceResponse, error)
+	// Returns a maintenance job with its progress
+	GetMaintenanceJob(ctx context.Context, in *GetMaintenanceJobRequest, opts ...grpc.CallOption) (*GetMaintenanceJobResponse, error)
+	ListMaintenanceJobs(ctx context.Context, in *ListMaintenanceJobsRequest, opts ...grpc.CallOption) (*ListMaintenanceJobsResponse, error)
+	// Cancels the statement of a running job
+	CancelMaintenanceJob(ctx context.Context, in *CancelMaintenanceJobRequest, opts ...grpc.CallOption) (*CancelMaintenanceJobResponse, error)
+	// Returns aggregated metrics collected in the background for a time range, without querying PostgreSQL
+	ListMetricSamples(ctx context.Context, in *ListMetricSamplesRequest, opts ...grpc.CallOption) (*ListMetricSamplesResponse, error)
+	// Returns knobs collected in the background for a time range, without querying PostgreSQL
+	ListKnobSamples(ctx context.Context, in *ListKnobSamplesRequest, opts ...grpc.CallOption) (*ListKnobSamplesResponse, error)
 }
 
 type collectorClient struct {
@@ -65,6 +120,38 @@ func (c *collectorClient) CollectInternalMetrics(ctx context.Context, in *Collec
 	return out, nil
 }
 
+func (c *collectorClient) StreamInternalMetrics(ctx context.Context, in *StreamInternalMetricsRequest, opts ...grpc.CallOption) (Collector_StreamInternalMetricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Collector_ServiceDesc.Streams[0], Collector_StreamInternalMetrics_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &collectorStreamInternalMetricsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Collector_StreamInternalMetricsClient interface {
+	Recv() (*StreamInternalMetricsResponse, error)
+	grpc.ClientStream
+}
+
+type collectorStreamInternalMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *collectorStreamInternalMetricsClient) Recv() (*StreamInternalMetricsResponse, error) {
+	m := new(StreamInternalMetricsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *collectorClient) CollectExternalMetrics(ctx context.Context, in *CollectExternalMetricsRequest, opts ...grpc.CallOption) (*CollectExternalMetricsResponse, error) {
 	out := new(CollectExternalMetricsResponse)
 	err := c.cc.Invoke(ctx, Collector_CollectExternalMetrics_FullMethodName, in, out, opts...)
@@ -92,6 +179,168 @@ func (c *collectorClient) SetKnobs(ctx context.Context, in *SetKnobsRequest, opt
 	return out, nil
 }
 
+func (c *collectorClient) CreateKnobSnapshot(ctx context.Context, in *CreateKnobSnapshotRequest, opts ...grpc.CallOption) (*CreateKnobSnapshotResponse, error) {
+	out := new(CreateKnobSnapshotResponse)
+	err := c.cc.Invoke(ctx, Collector_CreateKnobSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) ListKnobSnapshots(ctx context.Context, in *ListKnobSnapshotsRequest, opts ...grpc.CallOption) (*ListKnobSnapshotsResponse, error) {
+	out := new(ListKnobSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Collector_ListKnobSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) RestoreKnobSnapshot(ctx context.Context, in *RestoreKnobSnapshotRequest, opts ...grpc.CallOption) (*RestoreKnobSnapshotResponse, error) {
+	out := new(RestoreKnobSnapshotResponse)
+	err := c.cc.Invoke(ctx, Collector_RestoreKnobSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) ResetKnobs(ctx context.Context, in *ResetKnobsRequest, opts ...grpc.CallOption) (*ResetKnobsResponse, error) {
+	out := new(ResetKnobsResponse)
+	err := c.cc.Invoke(ctx, Collector_ResetKnobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) CollectTopStatements(ctx context.Context, in *CollectTopStatementsRequest, opts ...grpc.CallOption) (*CollectTopStatementsResponse, error) {
+	out := new(CollectTopStatementsResponse)
+	err := c.cc.Invoke(ctx, Collector_CollectTopStatements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) CollectBlockingTree(ctx context.Context, in *CollectBlockingTreeRequest, opts ...grpc.CallOption) (*CollectBlockingTreeResponse, error) {
+	out := new(CollectBlockingTreeResponse)
+	err := c.cc.Invoke(ctx, Collector_CollectBlockingTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) CollectWaitEvents(ctx context.Context, in *CollectWaitEventsRequest, opts ...grpc.CallOption) (*CollectWaitEventsResponse, error) {
+	out := new(CollectWaitEventsResponse)
+	err := c.cc.Invoke(ctx, Collector_CollectWaitEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) CollectReplication(ctx context.Context, in *CollectReplicationRequest, opts ...grpc.CallOption) (*CollectReplicationResponse, error) {
+	out := new(CollectReplicationResponse)
+	err := c.cc.Invoke(ctx, Collector_CollectReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) CollectAutovacuumState(ctx context.Context, in *CollectAutovacuumStateRequest, opts ...grpc.CallOption) (*CollectAutovacuumStateResponse, error) {
+	out := new(CollectAutovacuumStateResponse)
+	err := c.cc.Invoke(ctx, Collector_CollectAutovacuumState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) RecommendTableOptions(ctx context.Context, in *RecommendTableOptionsRequest, opts ...grpc.CallOption) (*RecommendTableOptionsResponse, error) {
+	out := new(RecommendTableOptionsResponse)
+	err := c.cc.Invoke(ctx, Collector_RecommendTableOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) SetTableOptions(ctx context.Context, in *SetTableOptionsRequest, opts ...grpc.CallOption) (*SetTableOptionsResponse, error) {
+	out := new(SetTableOptionsResponse)
+	err := c.cc.Invoke(ctx, Collector_SetTableOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) RecommendIndexes(ctx context.Context, in *RecommendIndexesRequest, opts ...grpc.CallOption) (*RecommendIndexesResponse, error) {
+	out := new(RecommendIndexesResponse)
+	err := c.cc.Invoke(ctx, Collector_RecommendIndexes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) StartMaintenance(ctx context.Context, in *StartMaintenanceRequest, opts ...grpc.CallOption) (*StartMaintenanceResponse, error) {
+	out := new(StartMaintenanceResponse)
+	err := c.cc.Invoke(ctx, Collector_StartMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) GetMaintenanceJob(ctx context.Context, in *GetMaintenanceJobRequest, opts ...grpc.CallOption) (*GetMaintenanceJobResponse, error) {
+	out := new(GetMaintenanceJobResponse)
+	err := c.cc.Invoke(ctx, Collector_GetMaintenanceJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) ListMaintenanceJobs(ctx context.Context, in *ListMaintenanceJobsRequest, opts ...grpc.CallOption) (*ListMaintenanceJobsResponse, error) {
+	out := new(ListMaintenanceJobsResponse)
+	err := c.cc.Invoke(ctx, Collector_ListMaintenanceJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) CancelMaintenanceJob(ctx context.Context, in *CancelMaintenanceJobRequest, opts ...grpc.CallOption) (*CancelMaintenanceJobResponse, error) {
+	out := new(CancelMaintenanceJobResponse)
+	err := c.cc.Invoke(ctx, Collector_CancelMaintenanceJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) ListMetricSamples(ctx context.Context, in *ListMetricSamplesRequest, opts ...grpc.CallOption) (*ListMetricSamplesResponse, error) {
+	out := new(ListMetricSamplesResponse)
+	err := c.cc.Invoke(ctx, Collector_ListMetricSamples_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) ListKnobSamples(ctx context.Context, in *ListKnobSamplesRequest, opts ...grpc.CallOption) (*ListKnobSamplesResponse, error) {
+	out := new(ListKnobSamplesResponse)
+	err := c.cc.Invoke(ctx, Collector_ListKnobSamples_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectorServer is the server API for Collector service.
 // All implementations must embed UnimplementedCollectorServer
 // for forward compatibility
@@ -99,10 +348,46 @@ type CollectorServer interface {
 	// Collects PostgreSQL knobs
 	CollectKnobs(context.Context, *CollectKnobsRequest) (*CollectKnobsResponse, error)
 	CollectInternalMetrics(context.Context, *CollectInternalMetricsRequest) (*CollectInternalMetricsResponse, error)
+	// Sends aggregated metrics every interval until the client cancels, one collection loop serves all subscribers
+	StreamInternalMetrics(*StreamInternalMetricsRequest, Collector_StreamInternalMetricsServer) error
 	CollectExternalMetrics(context.Context, *CollectExternalMetricsRequest) (*CollectExternalMetricsResponse, error)
 	InitLoad(context.Context, *InitLoadRequest) (*InitLoadResponse, error)
 	// Применяет параметры конфигурации
 	SetKnobs(context.Context, *SetKnobsRequest) (*SetKnobsResponse, error)
+	// Saves current values of all tunable knobs under a name
+	CreateKnobSnapshot(context.Context, *CreateKnobSnapshotRequest) (*CreateKnobSnapshotResponse, error)
+	ListKnobSnapshots(context.Context, *ListKnobSnapshotsRequest) (*ListKnobSnapshotsResponse, error)
+	// Sets knobs that differ from the snapshot back to the saved values
+	RestoreKnobSnapshot(context.Context, *RestoreKnobSnapshotRequest) (*RestoreKnobSnapshotResponse, error)
+	// Removes all ALTER SYSTEM overrides (ALTER SYSTEM RESET ALL)
+	ResetKnobs(context.Context, *ResetKnobsRequest) (*ResetKnobsResponse, error)
+	// Returns top statements of pg_stat_statements aggregated per queryid
+	CollectTopStatements(context.Context, *CollectTopStatementsRequest) (*CollectTopStatementsResponse, error)
+	// Returns backends waiting for locks arranged by the backends blocking them
+	CollectBlockingTree(context.Context, *CollectBlockingTreeRequest) (*CollectBlockingTreeResponse, error)
+	// Returns the histogram of wait events sampled from pg_stat_activity
+	CollectWaitEvents(context.Context, *CollectWaitEventsRequest) (*CollectWaitEventsResponse, error)
+	// Returns the role of the server, its replicas and replication slots
+	CollectReplication(context.Context, *CollectReplicationRequest) (*CollectReplicationResponse, error)
+	// Returns autovacuum settings, per-table vacuum statistics and long-running transactions
+	CollectAutovacuumState(context.Context, *CollectAutovacuumStateRequest) (*CollectAutovacuumStateResponse, error)
+	// Proposes per-table autovacuum settings and fillfactor with a rationale
+	RecommendTableOptions(context.Context, *RecommendTableOptionsRequest) (*RecommendTableOptionsResponse, error)
+	// Sets table storage parameters with ALTER TABLE ... SET, all tables are changed or none
+	SetTableOptions(context.Context, *SetTableOptionsRequest) (*SetTableOptionsResponse, error)
+	// Reports unused, duplicate, overlapping, invalid and bloated indexes with statements to fix them
+	RecommendIndexes(context.Context, *RecommendIndexesRequest) (*RecommendIndexesResponse, error)
+	// Starts VACUUM (ANALYZE), ANALYZE or REINDEX CONCURRENTLY as background jobs, one per relation
+	StartMaintenance(context.Context, *StartMaintenanceRequest) (*StartMaintenanceResponse, error)
+	// Returns a maintenance job with its progress
+	GetMaintenanceJob(context.Context, *GetMaintenanceJobRequest) (*GetMaintenanceJobResponse, error)
+	ListMaintenanceJobs(context.Context, *ListMaintenanceJobsRequest) (*ListMaintenanceJobsResponse, error)
+	// Cancels the statement of a running job
+	CancelMaintenanceJob(context.Context, *CancelMaintenanceJobRequest) (*CancelMaintenanceJobResponse, error)
+	// Returns aggregated metrics collected in the background for a time range, without querying PostgreSQL
+	ListMetricSamples(context.Context, *ListMetricSamplesRequest) (*ListMetricSamplesResponse, error)
+	// Returns knobs collected in the background for a time range, without querying PostgreSQL
+	ListKnobSamples(context.Context, *ListKnobSamplesRequest) (*ListKnobSamplesResponse, error)
 	mustEmbedUnimplementedCollectorServer()
 }
 
@@ -116,6 +401,9 @@ func (UnimplementedCollectorServer) CollectKnobs(context.Context, *CollectKnobsR
 func (UnimplementedCollectorServer) CollectInternalMetrics(context.Context, *CollectInternalMetricsRequest) (*CollectInternalMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectInternalMetrics not implemented")
 }
+func (UnimplementedCollectorServer) StreamInternalMetrics(*StreamInternalMetricsRequest, Collector_StreamInternalMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInternalMetrics not implemented")
+}
 func (UnimplementedCollectorServer) CollectExternalMetrics(context.Context, *CollectExternalMetricsRequest) (*CollectExternalMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectExternalMetrics not implemented")
 }
@@ -125,6 +413,60 @@ func (UnimplementedCollectorServer) InitLoad(context.Context, *InitLoadRequest)
 func (UnimplementedCollectorServer) SetKnobs(context.Context, *SetKnobsRequest) (*SetKnobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetKnobs not implemented")
 }
+func (UnimplementedCollectorServer) CreateKnobSnapshot(context.Context, *CreateKnobSnapshotRequest) (*CreateKnobSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKnobSnapshot not implemented")
+}
+func (UnimplementedCollectorServer) ListKnobSnapshots(context.Context, *ListKnobSnapshotsRequest) (*ListKnobSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKnobSnapshots not implemented")
+}
+func (UnimplementedCollectorServer) RestoreKnobSnapshot(context.Context, *RestoreKnobSnapshotRequest) (*RestoreKnobSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreKnobSnapshot not implemented")
+}
+func (UnimplementedCollectorServer) ResetKnobs(context.Context, *ResetKnobsRequest) (*ResetKnobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetKnobs not implemented")
+}
+func (UnimplementedCollectorServer) CollectTopStatements(context.Context, *CollectTopStatementsRequest) (*CollectTopStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectTopStatements not implemented")
+}
+func (UnimplementedCollectorServer) CollectBlockingTree(context.Context, *CollectBlockingTreeRequest) (*CollectBlockingTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectBlockingTree not implemented")
+}
+func (UnimplementedCollectorServer) CollectWaitEvents(context.Context, *CollectWaitEventsRequest) (*CollectWaitEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectWaitEvents not implemented")
+}
+func (UnimplementedCollectorServer) CollectReplication(context.Context, *CollectReplicationRequest) (*CollectReplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectReplication not implemented")
+}
+func (UnimplementedCollectorServer) CollectAutovacuumState(context.Context, *CollectAutovacuumStateRequest) (*CollectAutovacuumStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAutovacuumState not implemented")
+}
+func (UnimplementedCollectorServer) RecommendTableOptions(context.Context, *RecommendTableOptionsRequest) (*RecommendTableOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendTableOptions not implemented")
+}
+func (UnimplementedCollectorServer) SetTableOptions(context.Context, *SetTableOptionsRequest) (*SetTableOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTableOptions not implemented")
+}
+func (UnimplementedCollectorServer) RecommendIndexes(context.Context, *RecommendIndexesRequest) (*RecommendIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendIndexes not implemented")
+}
+func (UnimplementedCollectorServer) StartMaintenance(context.Context, *StartMaintenanceRequest) (*StartMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMaintenance not implemented")
+}
+func (UnimplementedCollectorServer) GetMaintenanceJob(context.Context, *GetMaintenanceJobRequest) (*GetMaintenanceJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceJob not implemented")
+}
+func (UnimplementedCollectorServer) ListMaintenanceJobs(context.Context, *ListMaintenanceJobsRequest) (*ListMaintenanceJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceJobs not implemented")
+}
+func (UnimplementedCollectorServer) CancelMaintenanceJob(context.Context, *CancelMaintenanceJobRequest) (*CancelMaintenanceJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenanceJob not implemented")
+}
+func (UnimplementedCollectorServer) ListMetricSamples(context.Context, *ListMetricSamplesRequest) (*ListMetricSamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetricSamples not implemented")
+}
+func (UnimplementedCollectorServer) ListKnobSamples(context.Context, *ListKnobSamplesRequest) (*ListKnobSamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKnobSamples not implemented")
+}
 func (UnimplementedCollectorServer) mustEmbedUnimplementedCollectorServer() {}
 
 // UnsafeCollectorServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +516,27 @@ func _Collector_CollectInternalMetrics_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Collector_StreamInternalMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamInternalMetricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectorServer).StreamInternalMetrics(m, &collectorStreamInternalMetricsServer{stream})
+}
+
+type Collector_StreamInternalMetricsServer interface {
+	Send(*StreamInternalMetricsResponse) error
+	grpc.ServerStream
+}
+
+type collectorStreamInternalMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *collectorStreamInternalMetricsServer) Send(m *StreamInternalMetricsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Collector_CollectExternalMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CollectExternalMetricsRequest)
 	if err := dec(in); err != nil {
@@ -228,6 +591,330 @@ func _Collector_SetKnobs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Collector_CreateKnobSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKnobSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CreateKnobSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CreateKnobSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CreateKnobSnapshot(ctx, req.(*CreateKnobSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_ListKnobSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKnobSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).ListKnobSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_ListKnobSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).ListKnobSnapshots(ctx, req.(*ListKnobSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_RestoreKnobSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreKnobSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).RestoreKnobSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_RestoreKnobSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).RestoreKnobSnapshot(ctx, req.(*RestoreKnobSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_ResetKnobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetKnobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).ResetKnobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_ResetKnobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).ResetKnobs(ctx, req.(*ResetKnobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_CollectTopStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectTopStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CollectTopStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CollectTopStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CollectTopStatements(ctx, req.(*CollectTopStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_CollectBlockingTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectBlockingTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CollectBlockingTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CollectBlockingTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CollectBlockingTree(ctx, req.(*CollectBlockingTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_CollectWaitEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectWaitEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CollectWaitEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CollectWaitEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CollectWaitEvents(ctx, req.(*CollectWaitEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_CollectReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CollectReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CollectReplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CollectReplication(ctx, req.(*CollectReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_CollectAutovacuumState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectAutovacuumStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CollectAutovacuumState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CollectAutovacuumState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CollectAutovacuumState(ctx, req.(*CollectAutovacuumStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_RecommendTableOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendTableOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).RecommendTableOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_RecommendTableOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).RecommendTableOptions(ctx, req.(*RecommendTableOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_SetTableOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTableOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).SetTableOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_SetTableOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).SetTableOptions(ctx, req.(*SetTableOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_RecommendIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).RecommendIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_RecommendIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).RecommendIndexes(ctx, req.(*RecommendIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_StartMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).StartMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_StartMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).StartMaintenance(ctx, req.(*StartMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_GetMaintenanceJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).GetMaintenanceJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_GetMaintenanceJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).GetMaintenanceJob(ctx, req.(*GetMaintenanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_ListMaintenanceJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).ListMaintenanceJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_ListMaintenanceJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).ListMaintenanceJobs(ctx, req.(*ListMaintenanceJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_CancelMaintenanceJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).CancelMaintenanceJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_CancelMaintenanceJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).CancelMaintenanceJob(ctx, req.(*CancelMaintenanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_ListMetricSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricSamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).ListMetricSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_ListMetricSamples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).ListMetricSamples(ctx, req.(*ListMetricSamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collector_ListKnobSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKnobSamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).ListKnobSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Collector_ListKnobSamples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).ListKnobSamples(ctx, req.(*ListKnobSamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Collector_ServiceDesc is the grpc.ServiceDesc for Collector service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,7 +942,85 @@ var Collector_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetKnobs",
 			Handler:    _Collector_SetKnobs_Handler,
 		},
+		{
+			MethodName: "CreateKnobSnapshot",
+			Handler:    _Collector_CreateKnobSnapshot_Handler,
+		},
+		{
+			MethodName: "ListKnobSnapshots",
+			Handler:    _Collector_ListKnobSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreKnobSnapshot",
+			Handler:    _Collector_RestoreKnobSnapshot_Handler,
+		},
+		{
+			MethodName: "ResetKnobs",
+			Handler:    _Collector_ResetKnobs_Handler,
+		},
+		{
+			MethodName: "CollectTopStatements",
+			Handler:    _Collector_CollectTopStatements_Handler,
+		},
+		{
+			MethodName: "CollectBlockingTree",
+			Handler:    _Collector_CollectBlockingTree_Handler,
+		},
+		{
+			MethodName: "CollectWaitEvents",
+			Handler:    _Collector_CollectWaitEvents_Handler,
+		},
+		{
+			MethodName: "CollectReplication",
+			Handler:    _Collector_CollectReplication_Handler,
+		},
+		{
+			MethodName: "CollectAutovacuumState",
+			Handler:    _Collector_CollectAutovacuumState_Handler,
+		},
+		{
+			MethodName: "RecommendTableOptions",
+			Handler:    _Collector_RecommendTableOptions_Handler,
+		},
+		{
+			MethodName: "SetTableOptions",
+			Handler:    _Collector_SetTableOptions_Handler,
+		},
+		{
+			MethodName: "RecommendIndexes",
+			Handler:    _Collector_RecommendIndexes_Handler,
+		},
+		{
+			MethodName: "StartMaintenance",
+			Handler:    _Collector_StartMaintenance_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceJob",
+			Handler:    _Collector_GetMaintenanceJob_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceJobs",
+			Handler:    _Collector_ListMaintenanceJobs_Handler,
+		},
+		{
+			MethodName: "CancelMaintenanceJob",
+			Handler:    _Collector_CancelMaintenanceJob_Handler,
+		},
+		{
+			MethodName: "ListMetricSamples",
+			Handler:    _Collector_ListMetricSamples_Handler,
+		},
+		{
+			MethodName: "ListKnobSamples",
+			Handler:    _Collector_ListKnobSamples_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamInternalMetrics",
+			Handler:       _Collector_StreamInternalMetrics_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "collector/colelctor.proto",
 }
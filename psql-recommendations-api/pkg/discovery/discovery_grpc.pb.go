@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Discovery_RegisterInstance_FullMethodName = "/discovery.Discovery/RegisterInstance"
 	Discovery_GetInstanceInfo_FullMethodName  = "/discovery.Discovery/GetInstanceInfo"
+	Discovery_RestartInstance_FullMethodName  = "/discovery.Discovery/RestartInstance"
 )
 
 // DiscoveryClient is the client API for Discovery service.
@@ -29,6 +30,8 @@ const (
 type DiscoveryClient interface {
 	RegisterInstance(ctx context.Context, in *RegisterInstanceRequest, opts ...grpc.CallOption) (*RegisterInstanceResponse, error)
 	GetInstanceInfo(ctx context.Context, in *GetInstanceInfoRequest, opts ...grpc.CallOption) (*GetInstanceInfoResponse, error)
+	// Restarts PostgreSQL container of the instance and waits until it is ready
+	RestartInstance(ctx context.Context, in *RestartInstanceRequest, opts ...grpc.CallOption) (*RestartInstanceResponse, error)
 }
 
 type discoveryClient struct {
@@ -57,12 +60,23 @@ func (c *discoveryClient) GetInstanceInfo(ctx context.Context, in *GetInstanceIn
 	return out, nil
 }
 
+func (c *discoveryClient) RestartInstance(ctx context.Context, in *RestartInstanceRequest, opts ...grpc.CallOption) (*RestartInstanceResponse, error) {
+	out := new(RestartInstanceResponse)
+	err := c.cc.Invoke(ctx, Discovery_RestartInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryServer is the server API for Discovery service.
 // All implementations must embed UnimplementedDiscoveryServer
 // for forward compatibility
 type DiscoveryServer interface {
 	RegisterInstance(context.Context, *RegisterInstanceRequest) (*RegisterInstanceResponse, error)
 	GetInstanceInfo(context.Context, *GetInstanceInfoRequest) (*GetInstanceInfoResponse, error)
+	// Restarts PostgreSQL container of the instance and waits until it is ready
+	RestartInstance(context.Context, *RestartInstanceRequest) (*RestartInstanceResponse, error)
 	mustEmbedUnimplementedDiscoveryServer()
 }
 
@@ -76,6 +90,9 @@ func (UnimplementedDiscoveryServer) RegisterInstance(context.Context, *RegisterI
 func (UnimplementedDiscoveryServer) GetInstanceInfo(context.Context, *GetInstanceInfoRequest) (*GetInstanceInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceInfo not implemented")
 }
+func (UnimplementedDiscoveryServer) RestartInstance(context.Context, *RestartInstanceRequest) (*RestartInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartInstance not implemented")
+}
 func (UnimplementedDiscoveryServer) mustEmbedUnimplementedDiscoveryServer() {}
 
 // UnsafeDiscoveryServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +142,24 @@ func _Discovery_GetInstanceInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Discovery_RestartInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServer).RestartInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Discovery_RestartInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServer).RestartInstance(ctx, req.(*RestartInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Discovery_ServiceDesc is the grpc.ServiceDesc for Discovery service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +175,10 @@ var Discovery_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstanceInfo",
 			Handler:    _Discovery_GetInstanceInfo_Handler,
 		},
+		{
+			MethodName: "RestartInstance",
+			Handler:    _Discovery_RestartInstance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "discovery/discovery.proto",